@@ -0,0 +1,206 @@
+package yacl
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// Redacted replaces values of secret fields wherever they are exposed.
+const Redacted = "[REDACTED]"
+
+// Change describes a value which differs between two configs.
+type Change struct {
+	Path string
+	Old  any
+	New  any
+}
+
+// Diff returns changes between configs a and b with secrets redacted.
+func Diff[T any](a, b *T) []Change {
+	var zero T
+
+	if a == nil {
+		a = &zero
+	}
+
+	if b == nil {
+		b = &zero
+	}
+
+	changes := make([]Change, 0)
+	diffValue(&changes, "", reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem(), false)
+
+	return changes
+}
+
+func diffValue(changes *[]Change, path string, a, b reflect.Value, secret bool) {
+	if a.Type() == secretType {
+		secret = true
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		if isLeafStruct(a.Type()) {
+			diffLeaf(changes, path, a, b, secret)
+
+			return
+		}
+
+		for i := 0; i < a.NumField(); i++ {
+			fieldType := a.Type().Field(i)
+			if !fieldType.IsExported() {
+				continue
+			}
+
+			fieldPath := fieldType.Name
+			if path != "" {
+				fieldPath = path + "." + fieldPath
+			}
+
+			fieldSecret := secret || isSecretField(fieldType)
+			diffValue(changes, fieldPath, a.Field(i), b.Field(i), fieldSecret)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if secret {
+			diffLeaf(changes, path, a, b, secret)
+
+			return
+		}
+
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+
+			switch {
+			case i >= a.Len():
+				addChange(changes, elemPath, nil, b.Index(i).Interface(), secret)
+			case i >= b.Len():
+				addChange(changes, elemPath, a.Index(i).Interface(), nil, secret)
+			default:
+				diffValue(changes, elemPath, a.Index(i), b.Index(i), secret)
+			}
+		}
+
+	case reflect.Map:
+		if secret {
+			diffLeaf(changes, path, a, b, secret)
+
+			return
+		}
+
+		for _, key := range mapKeys(a, b) {
+			elemPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+			aElem := a.MapIndex(key)
+			bElem := b.MapIndex(key)
+
+			switch {
+			case !aElem.IsValid():
+				addChange(changes, elemPath, nil, bElem.Interface(), secret)
+			case !bElem.IsValid():
+				addChange(changes, elemPath, aElem.Interface(), nil, secret)
+			default:
+				diffValue(changes, elemPath, aElem, bElem, secret)
+			}
+		}
+
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() || a.Elem().Type() != b.Elem().Type() {
+			diffLeaf(changes, path, a, b, secret)
+
+			return
+		}
+
+		diffValue(changes, path, a.Elem(), b.Elem(), secret)
+
+	default:
+		diffLeaf(changes, path, a, b, secret)
+	}
+}
+
+func diffLeaf(changes *[]Change, path string, a, b reflect.Value, secret bool) {
+	if equalValues(a, b) {
+		return
+	}
+
+	addChange(changes, path, a.Interface(), b.Interface(), secret)
+}
+
+// isLeafStruct reports whether the struct is compared as a whole, e.g. time.Time.
+func isLeafStruct(typ reflect.Type) bool {
+	if _, ok := equalMethod(typ); ok || reflect.PointerTo(typ).Implements(textMarshalerType) {
+		return true
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalMethod returns the Equal(T) bool method of the type, if any.
+func equalMethod(typ reflect.Type) (reflect.Method, bool) {
+	method, ok := typ.MethodByName("Equal")
+	if !ok || typ.Kind() == reflect.Interface {
+		return method, false
+	}
+
+	methodType := method.Type
+	ok = methodType.NumIn() == 2 && methodType.In(1) == typ &&
+		methodType.NumOut() == 1 && methodType.Out(0).Kind() == reflect.Bool
+
+	return method, ok
+}
+
+func equalValues(a, b reflect.Value) bool {
+	if a.Type() == b.Type() {
+		if method, ok := equalMethod(a.Type()); ok {
+			return method.Func.Call([]reflect.Value{a, b})[0].Bool()
+		}
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+func addChange(changes *[]Change, path string, old, new any, secret bool) {
+	if secret {
+		if old != nil {
+			old = Redacted
+		}
+
+		if new != nil {
+			new = Redacted
+		}
+	}
+
+	*changes = append(*changes, Change{Path: path, Old: old, New: new})
+}
+
+// mapKeys returns keys of both maps sorted by their string representation.
+func mapKeys(a, b reflect.Value) []reflect.Value {
+	keys := make([]reflect.Value, 0, a.Len()+b.Len())
+	seen := make(map[any]struct{})
+
+	for _, m := range []reflect.Value{a, b} {
+		for _, key := range m.MapKeys() {
+			if _, ok := seen[key.Interface()]; ok {
+				continue
+			}
+
+			seen[key.Interface()] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+
+	return keys
+}
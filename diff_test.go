@@ -0,0 +1,127 @@
+package yacl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	type Database struct {
+		Hostname string
+		Password string `secret:"true"`
+		Replicas []string
+	}
+
+	type Config struct {
+		Port     uint
+		Database Database
+		Labels   map[string]string
+		Tokens   []string `secret:"true"`
+	}
+
+	base := Config{
+		Port: 8080,
+		Database: Database{
+			Hostname: "localhost",
+			Password: "first-password",
+			Replicas: []string{"replica-1", "replica-2"},
+		},
+		Labels: map[string]string{"env": "dev", "team": "core"},
+		Tokens: []string{"first-token"},
+	}
+
+	testCases := []struct {
+		name     string
+		a        *Config
+		b        *Config
+		expected []Change
+	}{
+		{
+			name:     "equal",
+			a:        &base,
+			b:        &base,
+			expected: []Change{},
+		}, {
+			name: "changed",
+			a:    &base,
+			b: &Config{
+				Port: 8081,
+				Database: Database{
+					Hostname: "localhost",
+					Password: "second-password",
+					Replicas: []string{"replica-1", "replica-3", "replica-4"},
+				},
+				Labels: map[string]string{"env": "prod", "region": "eu"},
+				Tokens: []string{"second-token"},
+			},
+			expected: []Change{
+				{Path: "Port", Old: uint(8080), New: uint(8081)},
+				{Path: "Database.Password", Old: Redacted, New: Redacted},
+				{Path: "Database.Replicas[1]", Old: "replica-2", New: "replica-3"},
+				{Path: "Database.Replicas[2]", Old: nil, New: "replica-4"},
+				{Path: "Labels[env]", Old: "dev", New: "prod"},
+				{Path: "Labels[region]", Old: nil, New: "eu"},
+				{Path: "Labels[team]", Old: "core", New: nil},
+				{Path: "Tokens", Old: Redacted, New: Redacted},
+			},
+		}, {
+			name: "nil",
+			a:    nil,
+			b:    &Config{Port: 8080},
+			expected: []Change{
+				{Path: "Port", Old: uint(0), New: uint(8080)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Diff(tc.a, tc.b))
+		})
+	}
+}
+
+func TestDiff_Secret(t *testing.T) {
+	type Config struct {
+		Password Secret
+	}
+
+	changes := Diff(&Config{Password: "first"}, &Config{Password: "second"})
+	assert.Equal(t, []Change{{Path: "Password", Old: Redacted, New: Redacted}}, changes)
+}
+
+func TestDiff_InterfaceTypes(t *testing.T) {
+	type First struct {
+		A, B string
+	}
+
+	type Second struct {
+		A string
+	}
+
+	type Config struct {
+		Value any
+	}
+
+	a := &Config{Value: First{A: "a", B: "b"}}
+	b := &Config{Value: Second{A: "a"}}
+
+	assert.Equal(t, []Change{{Path: "Value", Old: First{A: "a", B: "b"}, New: Second{A: "a"}}}, Diff(a, b))
+	assert.Equal(t, []Change{{Path: "Value", Old: Second{A: "a"}, New: First{A: "a", B: "b"}}}, Diff(b, a))
+}
+
+func TestDiff_LeafStructs(t *testing.T) {
+	type Config struct {
+		At time.Time
+	}
+
+	a := &Config{At: time.Unix(0, 0).UTC()}
+	b := &Config{At: time.Unix(100, 0).UTC()}
+
+	assert.Equal(t, []Change{{Path: "At", Old: a.At, New: b.At}}, Diff(a, b))
+
+	// The same instant in another location is equal
+	assert.Empty(t, Diff(a, &Config{At: a.At.Local()}))
+}
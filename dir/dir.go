@@ -0,0 +1,135 @@
+package dir
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/andrew528i/yacl/file"
+	"github.com/andrew528i/yacl/utils"
+)
+
+var DefaultPath = ""
+var DefaultNaming = utils.SnakeCase
+
+// Params describes directories holding a file per config field.
+type Params struct {
+	Paths []string
+
+	// Naming makes keys of the dotted form like the ones of config files.
+	Naming utils.NamingStrategy
+}
+
+func DefaultParams(extraPaths ...string) *Params {
+	paths := make([]string, 0)
+
+	if DefaultPath != "" {
+		paths = append(paths, DefaultPath)
+	}
+
+	paths = append(paths, extraPaths...)
+
+	return &Params{
+		Paths:  paths,
+		Naming: DefaultNaming,
+	}
+}
+
+// Parse reads a value of every field from the file named after it.
+func Parse[T any](params *Params) (*T, error) {
+	var cfg T
+
+	dirs, err := listFiles(params.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	callback := func(fields []reflect.StructField, value reflect.Value) error {
+		var fullPath string
+
+		if !utils.FromSource(fields, utils.SourceDir) {
+			return nil
+		}
+
+		for i := len(dirs) - 1; i >= 0 && fullPath == ""; i-- {
+			for _, key := range Keys(params, fields) {
+				if p, ok := dirs[i][strings.ToLower(key)]; ok {
+					fullPath = p
+
+					break
+				}
+			}
+		}
+
+		if fullPath == "" {
+			return nil
+		}
+
+		content, err := os.ReadFile(fullPath)
+		if err != nil {
+			return err
+		}
+
+		return utils.SetFromString(value, strings.TrimSpace(string(content)))
+	}
+
+	if err = utils.WalkStructFields(&cfg, callback); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// Keys returns file names which may hold a value of the field.
+func Keys(params *Params, fields []reflect.StructField) []string {
+	if name := utils.TagName(fields[len(fields)-1].Tag, "yacl"); name != "" {
+		return []string{name}
+	}
+
+	keys := make([]string, 0, 2)
+
+	// Fields excluded from config files have no dotted form
+	if key := file.Key(&file.Params{Naming: params.Naming}, fields); key != "" {
+		keys = append(keys, key)
+	}
+
+	return append(keys, utils.ScreamingSnakeCase.Name(utils.FieldWords(fields, "env", ""), ""))
+}
+
+// listFiles maps lowercased file names to their full paths in every directory.
+func listFiles(paths []string) ([]map[string]string, error) {
+	dirs := make([]map[string]string, 0, len(paths))
+
+	for _, path := range paths {
+		files := make(map[string]string)
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+
+			fullPath := filepath.Join(path, entry.Name())
+
+			info, err := os.Stat(fullPath) // follows symlinks
+			if err != nil || info.IsDir() {
+				continue
+			}
+
+			files[strings.ToLower(entry.Name())] = fullPath
+		}
+
+		dirs = append(dirs, files)
+	}
+
+	return dirs, nil
+}
@@ -0,0 +1,136 @@
+package dir
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/andrew528i/yacl/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname string
+		Port     uint
+		Password string
+	}
+
+	type Config struct {
+		HTTPPort uint
+		Tags     []string
+		Alias    string `yacl:"custom-alias"`
+		Database DatabaseConfig
+	}
+
+	configDir := t.TempDir()
+	secretsDir := t.TempDir()
+
+	files := map[string]string{
+		filepath.Join(configDir, "http_port"):          "8080",
+		filepath.Join(configDir, "tags"):               "one,two",
+		filepath.Join(configDir, "custom-alias"):       "aliased",
+		filepath.Join(configDir, "database.hostname"):  "localhost",
+		filepath.Join(configDir, "DATABASE_PORT"):      "5432\n",
+		filepath.Join(configDir, "DATABASE_PASSWORD"):  "config-password",
+		filepath.Join(configDir, ".hidden"):            "ignored",
+		filepath.Join(secretsDir, "database_password"): "secret-password\n",
+	}
+
+	for path, content := range files {
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	assert.NoError(t, os.Mkdir(filepath.Join(configDir, "nested"), 0700))
+
+	cfg, err := Parse[Config](DefaultParams(configDir, filepath.Join(configDir, "missing"), secretsDir))
+	assert.NoError(t, err)
+	assert.Equal(t, Config{
+		HTTPPort: 8080,
+		Tags:     []string{"one", "two"},
+		Alias:    "aliased",
+		Database: DatabaseConfig{
+			Hostname: "localhost",
+			Port:     5432,
+			Password: "secret-password",
+		},
+	}, *cfg)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(configDir, "http_port"), []byte("not-a-number"), 0600))
+	_, err = Parse[Config](DefaultParams(configDir))
+	assert.Error(t, err)
+}
+
+func TestParse_Override(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname string
+	}
+
+	type Config struct {
+		Database DatabaseConfig
+	}
+
+	firstDir := t.TempDir()
+	secondDir := t.TempDir()
+
+	assert.NoError(t, os.WriteFile(filepath.Join(firstDir, "database.hostname"), []byte("first"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(secondDir, "DATABASE_HOSTNAME"), []byte("second"), 0600))
+
+	cfg, err := Parse[Config](DefaultParams(firstDir, secondDir))
+	assert.NoError(t, err)
+	assert.Equal(t, "second", cfg.Database.Hostname)
+
+	cfg, err = Parse[Config](DefaultParams(secondDir, firstDir))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", cfg.Database.Hostname)
+}
+
+func TestKeys(t *testing.T) {
+	type DatabaseConfig struct {
+		MaxConns int
+		Password string `key:"pass"`
+	}
+
+	type Config struct {
+		Database DatabaseConfig `key:"db" env:"DB"`
+	}
+
+	database := reflect.TypeOf(Config{}).Field(0)
+	maxConns := database.Type.Field(0)
+	password := database.Type.Field(1)
+
+	params := DefaultParams()
+	assert.Equal(t, []string{"db.max_conns", "DB_MAX_CONNS"}, Keys(params, []reflect.StructField{database, maxConns}))
+	assert.Equal(t, []string{"db.pass", "DB_PASSWORD"}, Keys(params, []reflect.StructField{database, password}))
+
+	params.Naming = utils.KebabCase
+	assert.Equal(t, []string{"db.max-conns", "DB_MAX_CONNS"}, Keys(params, []reflect.StructField{database, maxConns}))
+}
+
+func TestKeys_YAMLTags(t *testing.T) {
+	type TLSConfig struct {
+		CertFile string
+	}
+
+	type ServerConfig struct {
+		TLSConfig `yaml:",inline"`
+		Host      string `yaml:"hostname"`
+		Token     string `yacl:",nofile"`
+	}
+
+	type Config struct {
+		Server ServerConfig `yaml:"srv"`
+	}
+
+	server := reflect.TypeOf(Config{}).Field(0)
+	tls := server.Type.Field(0)
+	certFile := tls.Type.Field(0)
+	host := server.Type.Field(1)
+	token := server.Type.Field(2)
+
+	params := DefaultParams()
+	assert.Equal(t, []string{"srv.hostname", "SERVER_HOST"}, Keys(params, []reflect.StructField{server, host}))
+	assert.Equal(t, []string{"srv.cert_file", "SERVER_TLS_CONFIG_CERT_FILE"}, Keys(params, []reflect.StructField{server, tls, certFile}))
+	assert.Equal(t, []string{"SERVER_TOKEN"}, Keys(params, []reflect.StructField{server, token}))
+}
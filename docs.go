@@ -0,0 +1,217 @@
+package yacl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/andrew528i/yacl/env"
+	"github.com/andrew528i/yacl/file"
+	"github.com/andrew528i/yacl/flags"
+	"github.com/andrew528i/yacl/utils"
+)
+
+// FieldDoc describes a config field for the reference.
+type FieldDoc struct {
+	// Path is built from field names joined by dots, e.g. Database.Port
+	Path        string
+	Type        string
+	Default     string
+	Description string
+
+	// Env holds the variable names, the first of aliases goes first
+	Env  []string
+	Flag string
+
+	// Short is the short alias of the flag, e.g. p for -p
+	Short string
+
+	// Arg is the positional argument the field is bound to, e.g. <source>
+	Arg string
+
+	// Key is the key path in YAML files
+	Key string
+}
+
+// Reference describes every field of T with secrets redacted.
+func (s *YACL[T]) Reference(defaultConfigs ...*T) ([]FieldDoc, error) {
+	var cfg T
+
+	for _, defaultConfig := range defaultConfigs {
+		utils.MergeStruct(&cfg, defaultConfig)
+	}
+
+	docs := make([]FieldDoc, 0)
+	callback := func(fields []reflect.StructField, value reflect.Value) error {
+		field := fields[len(fields)-1]
+		doc := FieldDoc{
+			Path:        strings.Join(utils.FieldPath(fields), "."),
+			Type:        value.Type().String(),
+			Description: field.Tag.Get("description"),
+		}
+
+		if !value.IsZero() {
+			doc.Default = fmt.Sprint(value.Interface())
+
+			if isSecret(fields) {
+				doc.Default = Redacted
+			}
+		}
+
+		if utils.FromSource(fields, utils.SourceEnv) {
+			doc.Env = env.VariableNames(s.env, fields)
+		}
+
+		if utils.FromSource(fields, utils.SourceFlag) && !s.ignoreFlags {
+			doc.Flag = flags.FlagName(s.flags, fields)
+		}
+
+		if doc.Flag != "" {
+			doc.Short = field.Tag.Get("short")
+		}
+
+		if utils.FromSource(fields, utils.SourceFlag) && !s.ignoreFlags {
+			doc.Arg = flags.ArgName(s.flags, fields)
+		}
+
+		if utils.FromSource(fields, utils.SourceFile) {
+			doc.Key = file.Key(s.file, fields)
+		}
+
+		docs = append(docs, doc)
+
+		return nil
+	}
+
+	if err := utils.WalkStructFields(&cfg, callback); err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// Markdown renders the reference as a markdown table.
+func (s *YACL[T]) Markdown(defaultConfigs ...*T) (string, error) {
+	docs, err := s.Reference(defaultConfigs...)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	b.WriteString("| Field | Type | Default | Env | Flag | YAML key | Description |\n")
+	b.WriteString("|---|---|---|---|---|---|---|\n")
+
+	for _, doc := range docs {
+		envNames := make([]string, 0, len(doc.Env))
+		for _, name := range doc.Env {
+			envNames = append(envNames, markdownCode(name))
+		}
+
+		cells := []string{
+			markdownCode(doc.Path),
+			markdownCode(doc.Type),
+			markdownCode(doc.Default),
+			strings.Join(envNames, ", "),
+			markdownCode(doc.flagUsage()),
+			markdownCode(doc.Key),
+			markdownEscape(doc.Description),
+		}
+
+		_, _ = fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+
+	return b.String(), nil
+}
+
+// ManPage renders the reference as a roff man page of the program.
+func (s *YACL[T]) ManPage(program string, defaultConfigs ...*T) (string, error) {
+	docs, err := s.Reference(defaultConfigs...)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, ".TH %s 1\n", roffEscape(strings.ToUpper(program)))
+	b.WriteString(".SH NAME\n")
+	_, _ = fmt.Fprintf(&b, "%s \\- configuration reference\n", roffEscape(program))
+	b.WriteString(".SH CONFIGURATION\n")
+
+	for _, doc := range docs {
+		b.WriteString(".TP\n")
+		_, _ = fmt.Fprintf(&b, ".B %s\n", roffEscape(doc.Path))
+
+		lines := make([]string, 0)
+		if doc.Description != "" {
+			lines = append(lines, doc.Description)
+		}
+
+		lines = append(lines, "Type: "+doc.Type)
+
+		if doc.Default != "" {
+			lines = append(lines, "Default: "+doc.Default)
+		}
+
+		if len(doc.Env) > 0 {
+			lines = append(lines, "Environment: "+strings.Join(doc.Env, ", "))
+		}
+
+		if doc.Flag != "" {
+			lines = append(lines, "Flag: "+doc.flagUsage())
+		}
+
+		if doc.Arg != "" {
+			lines = append(lines, "Argument: "+doc.Arg)
+		}
+
+		if doc.Key != "" {
+			lines = append(lines, "YAML key: "+doc.Key)
+		}
+
+		for i, line := range lines {
+			if i > 0 {
+				b.WriteString(".br\n")
+			}
+
+			_, _ = fmt.Fprintln(&b, roffEscape(line))
+		}
+	}
+
+	return b.String(), nil
+}
+
+// flagUsage returns the flag the way usage prints it, e.g. -p, --port.
+func (s FieldDoc) flagUsage() string {
+	if s.Flag == "" {
+		return s.Arg
+	}
+
+	if s.Short != "" {
+		return "-" + s.Short + ", --" + s.Flag
+	}
+
+	return "--" + s.Flag
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	return "`" + strings.ReplaceAll(s, "|", `\|`) + "`"
+}
+
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
+
+func roffEscape(s string) string {
+	s = strings.NewReplacer(`\`, `\e`, "-", `\-`, "\n", " ").Replace(s)
+
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+
+	return s
+}
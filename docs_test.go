@@ -0,0 +1,120 @@
+package yacl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type docsConfig struct {
+	Database struct {
+		Host     string `env:"HOST,/DB_HOST" description:"host of the database"`
+		Port     uint   `short:"p" key:"port_number"`
+		Password Secret
+	}
+
+	Debug bool   `yacl:",noenv" sources:"env,flag"`
+	Input string `arg:"0" yacl:",noenv,nofile"`
+}
+
+func TestYACL_Reference(t *testing.T) {
+	defaultCfg := &docsConfig{}
+	defaultCfg.Database.Port = 5432
+	defaultCfg.Database.Password = "qwerty"
+
+	y := New[docsConfig]()
+	y.SetEnvPrefix("APP")
+
+	docs, err := y.Reference(defaultCfg)
+	assert.NoError(t, err)
+
+	expected := []FieldDoc{
+		{
+			Path:        "Database.Host",
+			Type:        "string",
+			Description: "host of the database",
+			Env:         []string{"APP_DATABASE_HOST", "APP_DB_HOST"},
+			Flag:        "database-host",
+			Key:         "database.host",
+		},
+		{
+			Path:    "Database.Port",
+			Type:    "uint",
+			Default: "5432",
+			Env:     []string{"APP_DATABASE_PORT"},
+			Flag:    "database-port",
+			Short:   "p",
+			Key:     "database.port_number",
+		},
+		{
+			Path:    "Database.Password",
+			Type:    "yacl.Secret",
+			Default: Redacted,
+			Env:     []string{"APP_DATABASE_PASSWORD"},
+			Flag:    "database-password",
+			Key:     "database.password",
+		},
+		{
+			Path: "Debug",
+			Type: "bool",
+			Flag: "debug",
+		},
+		{
+			Path: "Input",
+			Type: "string",
+			Arg:  "<input>",
+		},
+	}
+
+	assert.Equal(t, expected, docs)
+}
+
+func TestMarkdown(t *testing.T) {
+	markdown, err := Markdown[docsConfig]()
+	assert.NoError(t, err)
+
+	expected := "| Field | Type | Default | Env | Flag | YAML key | Description |\n" +
+		"|---|---|---|---|---|---|---|\n" +
+		"| `Database.Host` | `string` |  | `DATABASE_HOST`, `DB_HOST` | `--database-host` | `database.host` | host of the database |\n" +
+		"| `Database.Port` | `uint` |  | `DATABASE_PORT` | `-p, --database-port` | `database.port_number` |  |\n" +
+		"| `Database.Password` | `yacl.Secret` |  | `DATABASE_PASSWORD` | `--database-password` | `database.password` |  |\n" +
+		"| `Debug` | `bool` |  |  | `--debug` |  |  |\n" +
+		"| `Input` | `string` |  |  | `<input>` |  |  |\n"
+
+	assert.Equal(t, expected, markdown)
+}
+
+func TestManPage(t *testing.T) {
+	type Config struct {
+		LogLevel string `description:"level of logs"`
+		Input    string `arg:"0" yacl:",noenv,nofile"`
+	}
+
+	page, err := ManPage[Config]("svc", &Config{LogLevel: "info"})
+	assert.NoError(t, err)
+
+	expected := ".TH SVC 1\n" +
+		".SH NAME\n" +
+		"svc \\- configuration reference\n" +
+		".SH CONFIGURATION\n" +
+		".TP\n" +
+		".B LogLevel\n" +
+		"level of logs\n" +
+		".br\n" +
+		"Type: string\n" +
+		".br\n" +
+		"Default: info\n" +
+		".br\n" +
+		"Environment: LOG_LEVEL\n" +
+		".br\n" +
+		"Flag: \\-\\-log\\-level\n" +
+		".br\n" +
+		"YAML key: log_level\n" +
+		".TP\n" +
+		".B Input\n" +
+		"Type: string\n" +
+		".br\n" +
+		"Argument: <input>\n"
+
+	assert.Equal(t, expected, page)
+}
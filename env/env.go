@@ -1,185 +1,211 @@
 package env
 
 import (
-	"fmt"
+	"errors"
+	"log"
 	"os"
 	"reflect"
-	"strconv"
+	"sort"
 	"strings"
 
 	"github.com/andrew528i/yacl/utils"
 )
 
 var DefaultPrefix = ""
+
+// DefaultDelimiter separates words of variable names.
 var DefaultDelimiter = "_"
+var DefaultNaming = utils.ScreamingSnakeCase
+
+// FileSuffix marks variables holding a path to the file with the value.
+const FileSuffix = "file"
+
+// UnknownPolicy tells what to do with variables having Prefix but no field.
+type UnknownPolicy int
+
+const (
+	IgnoreUnknown UnknownPolicy = iota
+	WarnUnknown
+	RejectUnknown
+)
+
+var DefaultUnknownPolicy = IgnoreUnknown
 
 type Params struct {
 	Prefix    string
 	Delimiter string
+	Naming    utils.NamingStrategy
+
+	// Unknown is only applied when Prefix is set.
+	Unknown UnknownPolicy
+
+	// Warn receives unknown variables, the standard logger is used when it's nil.
+	Warn func(err error)
+
+	// Environ holds variables like os.Environ, which is used when it's nil.
+	Environ []string
 }
 
 func DefaultParams() *Params {
 	return &Params{
 		Prefix:    DefaultPrefix,
 		Delimiter: DefaultDelimiter,
+		Naming:    DefaultNaming,
+		Unknown:   DefaultUnknownPolicy,
 	}
 }
 
 func Parse[T any](params *Params) (*T, error) {
 	var cfg T
+	vars := utils.EnvironMap(params.Environ)
 	names := make([]string, 0)
-	callback := func(fieldPath []string, value reflect.Value, tag *reflect.StructTag) error {
-		var name string
-
-		fieldPathCopy := make([]string, 0, len(fieldPath))
-
-		for _, p := range fieldPath {
-			fieldPathCopy = append(fieldPathCopy, utils.CamelCaseToSlice(p)...)
-		}
-
-		if tag != nil && tag.Get("yacl") != "" {
-			// TODO: add description and default values parsing from tag
-			name = tag.Get("yacl")
-		} else {
-			name = strings.Join(fieldPathCopy, params.Delimiter)
-		}
-
-		if params.Prefix != "" {
-			name = fmt.Sprintf("%s%s%s", params.Prefix, params.Delimiter, name)
-		}
-
-		name = strings.ToUpper(name)
-		envVal := os.Getenv(name)
+	callback := func(fields []reflect.StructField, value reflect.Value) error {
+		var envVal string
 
-		if envVal == "" {
+		if !utils.FromSource(fields, utils.SourceEnv) {
 			return nil
 		}
 
-		switch value.Kind() {
-		case reflect.String:
-			value.SetString(envVal)
+		fieldNames := VariableNames(params, fields)
+		names = append(names, fieldNames...)
 
-		case reflect.Bool:
-			val, err := strconv.ParseBool(envVal)
+		// The first of aliases set wins
+		for _, name := range fieldNames {
+			v, err := lookup(vars, name, fileSuffix(params))
 			if err != nil {
 				return err
 			}
 
-			value.SetBool(val)
+			if v != "" {
+				envVal = v
 
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			val, err := strconv.ParseUint(envVal, 10, 64)
-			if err != nil {
-				return err
+				break
 			}
+		}
 
-			value.SetUint(val)
-
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			val, err := strconv.ParseInt(envVal, 10, 64)
-			if err != nil {
-				return err
-			}
+		if envVal == "" {
+			return nil
+		}
 
-			value.SetInt(val)
+		if err := utils.SetFromString(value, envVal); err != nil {
+			return err
+		}
 
-		case reflect.Float64:
-			val, err := strconv.ParseFloat(envVal, 64)
-			if err != nil {
-				return err
-			}
+		return nil
+	}
 
-			value.SetFloat(val)
+	if err := utils.WalkStructFields(&cfg, callback); err != nil {
+		return nil, err
+	}
 
-		case reflect.Slice:
-			elemKind := value.Type().Elem().Kind()
-			switch elemKind {
-			case reflect.String:
-				vals := strings.Split(envVal, ",")
-				slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+	if err := checkUnknown(params, vars, names); err != nil {
+		return nil, err
+	}
 
-				for i, v := range vals {
-					slice.Index(i).SetString(v)
-				}
+	return &cfg, nil
+}
 
-				value.Set(slice)
+// VariableNames builds names of the variable from the prefix and the field path.
+func VariableNames(params *Params, fields []reflect.StructField) []string {
+	field := fields[len(fields)-1]
+	aliases := []string{""}
 
-			case reflect.Bool:
-				vals := strings.Split(envVal, ",")
-				slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+	if name := utils.TagName(field.Tag, "yacl"); name != "" && field.Tag.Get("env") == "" {
+		aliases = []string{"/" + name}
+	}
 
-				for i, v := range vals {
-					val, err := strconv.ParseBool(v)
-					if err != nil {
-						return err
-					}
+	aliases = append(aliases, utils.TagOptions(field.Tag, "env")...)
 
-					slice.Index(i).SetBool(val)
-				}
+	names := make([]string, 0, len(aliases))
 
-				value.Set(slice)
+	for _, alias := range aliases {
+		words := make([]string, 0, len(fields)+1)
 
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				vals := strings.Split(envVal, ",")
-				slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+		if params.Prefix != "" {
+			words = append(words, strings.ToLower(params.Prefix))
+		}
 
-				for i, v := range vals {
-					val, err := strconv.ParseUint(v, 10, 64)
-					if err != nil {
-						return err
-					}
+		words = append(words, utils.FieldWords(fields, "env", alias)...)
+		names = append(names, params.Naming.Name(words, params.Delimiter))
+	}
 
-					slice.Index(i).SetUint(val)
-				}
+	return names
+}
 
-				value.Set(slice)
+func checkUnknown(params *Params, vars map[string]string, names []string) error {
+	if params.Prefix == "" || params.Unknown == IgnoreUnknown {
+		return nil
+	}
 
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				vals := strings.Split(envVal, ",")
-				slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+	suffix := fileSuffix(params)
+	known := make(map[string]struct{}, len(names)*2)
 
-				for i, v := range vals {
-					val, err := strconv.ParseInt(v, 10, 64)
-					if err != nil {
-						return err
-					}
+	for _, name := range names {
+		known[name] = struct{}{}
+		known[name+suffix] = struct{}{}
+	}
 
-					slice.Index(i).SetInt(val)
-				}
+	// The prefix followed by the delimiter, e.g. APP_
+	prefix := params.Naming.Name([]string{strings.ToLower(params.Prefix), ""}, params.Delimiter)
+	unknown := make([]string, 0)
 
-				value.Set(slice)
+	for name := range vars {
+		if _, ok := known[name]; !ok && strings.HasPrefix(name, prefix) {
+			unknown = append(unknown, name)
+		}
+	}
 
-			case reflect.Float64:
-				vals := strings.Split(envVal, ",")
-				slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+	sort.Strings(unknown)
 
-				for i, v := range vals {
-					val, err := strconv.ParseFloat(v, 64)
-					if err != nil {
-						return err
-					}
+	errs := make([]error, 0, len(unknown))
 
-					slice.Index(i).SetFloat(val)
-				}
+	for _, name := range unknown {
+		suggestion := utils.Closest(strings.TrimSuffix(name, suffix), names)
+		if suggestion != "" && strings.HasSuffix(name, suffix) {
+			suggestion += suffix
+		}
 
-				value.Set(slice)
+		errs = append(errs, NewUnknownVariable(name, suggestion))
+	}
 
-			default:
-				panic(fmt.Sprintf("slice type not supported: `%s`", value.Type().Name()))
-			}
+	if params.Unknown == RejectUnknown {
+		return errors.Join(errs...)
+	}
 
-		default:
-			panic(fmt.Sprintf("type not supported: `%s`", value.Type().Name()))
+	for _, err := range errs {
+		if params.Warn != nil {
+			params.Warn(err)
+		} else {
+			log.Println(err)
 		}
+	}
+
+	return nil
+}
 
-		names = append(names, name)
+// lookup falls back to the file pointed by the variable with suffix.
+func lookup(vars map[string]string, name, suffix string) (string, error) {
+	fileName := name + suffix
+	value := vars[name]
+	path := vars[fileName]
 
-		return nil
+	if path == "" {
+		return value, nil
 	}
 
-	if err := utils.WalkStruct(&cfg, callback); err != nil {
-		return nil, err
+	if value != "" {
+		return "", NewConflictingVariables(name, fileName)
 	}
 
-	return &cfg, nil
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", NewUnreadableFile(fileName, path, err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+// fileSuffix returns FileSuffix the way it's appended to names, e.g. _FILE.
+func fileSuffix(params *Params) string {
+	return params.Naming.Name([]string{"", FileSuffix}, params.Delimiter)
 }
@@ -2,8 +2,10 @@ package env
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/andrew528i/yacl/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -777,3 +779,237 @@ func TestParse_Float64Slice(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_Environ(t *testing.T) {
+	type Config struct {
+		Hostname string
+		Port     uint
+	}
+
+	assert.NoError(t, os.Setenv("HOSTNAME", "localhost-process"))
+
+	params := DefaultParams()
+	params.Environ = []string{"HOSTNAME=localhost-first", "PORT=8080", "HOSTNAME=localhost-second"}
+
+	cfg, err := Parse[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Hostname: "localhost-second", Port: 8080}, *cfg)
+
+	os.Clearenv()
+}
+
+func TestParse_File(t *testing.T) {
+	type DatabaseConfig struct {
+		Username string
+		Password string
+	}
+
+	type Config struct {
+		Database DatabaseConfig
+	}
+
+	tempDir := t.TempDir()
+	passwordFile := filepath.Join(tempDir, "db-password")
+	assert.NoError(t, os.WriteFile(passwordFile, []byte("file-password\n"), 0600))
+
+	testCases := []struct {
+		name     string
+		vars     map[string]string
+		expected Config
+		err      error
+	}{
+		{
+			name: "file",
+			vars: map[string]string{
+				"DATABASE_USERNAME":      "test-username",
+				"DATABASE_PASSWORD_FILE": passwordFile,
+			},
+			expected: Config{
+				Database: DatabaseConfig{
+					Username: "test-username",
+					Password: "file-password",
+				},
+			},
+		}, {
+			name: "plain",
+			vars: map[string]string{
+				"DATABASE_PASSWORD": "plain-password",
+			},
+			expected: Config{
+				Database: DatabaseConfig{
+					Password: "plain-password",
+				},
+			},
+		}, {
+			name: "both",
+			vars: map[string]string{
+				"DATABASE_PASSWORD":      "plain-password",
+				"DATABASE_PASSWORD_FILE": passwordFile,
+			},
+			err: &ConflictingVariables{},
+		}, {
+			name: "unreadable",
+			vars: map[string]string{
+				"DATABASE_PASSWORD_FILE": filepath.Join(tempDir, "missing"),
+			},
+			err: &UnreadableFile{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.vars {
+				assert.NoError(t, os.Setenv(k, v))
+			}
+
+			cfg, err := Parse[Config](DefaultParams())
+			if tc.err != nil {
+				assert.IsType(t, tc.err, err)
+				assert.Nil(t, cfg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, *cfg)
+			}
+
+			os.Clearenv()
+		})
+	}
+}
+
+func TestParse_Unknown(t *testing.T) {
+	type DatabaseConfig struct {
+		Host     string
+		Password string
+	}
+
+	type Config struct {
+		Database DatabaseConfig
+	}
+
+	environ := []string{
+		"APP_DATABASE_HOST=localhost",
+		"APP_DATABSE_HOST=typo",
+		"APP_DATABASE_PASSWORD_FILE=",
+		"APP_DATABASE_PASSWRD_FILE=",
+		"APP_COMPLETELY_DIFFERENT=1",
+		"OTHER_VARIABLE=1",
+	}
+
+	params := DefaultParams()
+	params.Prefix = "APP"
+	params.Environ = environ
+
+	// Ignored by default
+	_, err := Parse[Config](params)
+	assert.NoError(t, err)
+
+	// Warn
+	warnings := make([]string, 0)
+	params.Unknown = WarnUnknown
+	params.Warn = func(err error) {
+		warnings = append(warnings, err.Error())
+	}
+
+	cfg, err := Parse[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", cfg.Database.Host)
+	assert.Equal(t, []string{
+		"unknown variable APP_COMPLETELY_DIFFERENT",
+		"unknown variable APP_DATABASE_PASSWRD_FILE, did you mean APP_DATABASE_PASSWORD_FILE?",
+		"unknown variable APP_DATABSE_HOST, did you mean APP_DATABASE_HOST?",
+	}, warnings)
+
+	// Reject
+	params.Unknown = RejectUnknown
+	cfg, err = Parse[Config](params)
+	assert.Nil(t, cfg)
+
+	var unknownErr *UnknownVariable
+	assert.ErrorAs(t, err, &unknownErr)
+	assert.Equal(t, "APP_COMPLETELY_DIFFERENT", unknownErr.Name)
+	assert.Contains(t, err.Error(), "did you mean APP_DATABASE_HOST?")
+}
+
+func TestParse_Tags(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname string `env:"HOST"`
+		Password string `env:"PASS,/DB_PASSWORD"`
+		URL      string `env:"/DATABASE_URL"`
+		Port     uint   `yacl:"DB_PORT"`
+	}
+
+	type Config struct {
+		Database DatabaseConfig `env:"DB"`
+	}
+
+	testCases := []struct {
+		name     string
+		environ  []string
+		expected DatabaseConfig
+	}{
+		{
+			name:     "relative",
+			environ:  []string{"APP_DB_HOST=localhost", "APP_DB_PASS=secret"},
+			expected: DatabaseConfig{Hostname: "localhost", Password: "secret"},
+		}, {
+			name:     "absolute",
+			environ:  []string{"APP_DATABASE_URL=postgres://localhost", "APP_DB_PORT=5432"},
+			expected: DatabaseConfig{URL: "postgres://localhost", Port: 5432},
+		}, {
+			name:     "alias",
+			environ:  []string{"APP_DB_PASSWORD=legacy"},
+			expected: DatabaseConfig{Password: "legacy"},
+		}, {
+			name:     "first-alias-wins",
+			environ:  []string{"APP_DB_PASSWORD=legacy", "APP_DB_PASS=secret"},
+			expected: DatabaseConfig{Password: "secret"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Prefix = "APP"
+			params.Unknown = RejectUnknown
+			params.Environ = tc.environ
+
+			cfg, err := Parse[Config](params)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg.Database)
+		})
+	}
+}
+
+func TestParse_FileNaming(t *testing.T) {
+	type Config struct {
+		DatabasePassword string
+	}
+
+	tempDir := t.TempDir()
+	passwordFile := filepath.Join(tempDir, "db-password")
+	assert.NoError(t, os.WriteFile(passwordFile, []byte("file-password\n"), 0600))
+
+	testCases := []struct {
+		name      string
+		naming    utils.NamingStrategy
+		delimiter string
+		variable  string
+	}{
+		{name: "delimiter", naming: utils.ScreamingSnakeCase, delimiter: "__", variable: "DATABASE__PASSWORD__FILE"},
+		{name: "kebab", naming: utils.KebabCase, variable: "database-password-file"},
+		{name: "camel", naming: utils.CamelCase, variable: "databasePasswordFile"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Naming = tc.naming
+			params.Delimiter = tc.delimiter
+			params.Environ = []string{tc.variable + "=" + passwordFile}
+
+			cfg, err := Parse[Config](params)
+			assert.NoError(t, err)
+			assert.Equal(t, "file-password", cfg.DatabasePassword)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package env
+
+import "fmt"
+
+type ConflictingVariables struct {
+	Name     string
+	FileName string
+}
+
+func NewConflictingVariables(name, fileName string) *ConflictingVariables {
+	return &ConflictingVariables{
+		Name:     name,
+		FileName: fileName,
+	}
+}
+
+func (s ConflictingVariables) Error() string {
+	return fmt.Sprintf("both %v and %v are set, only one of them is allowed", s.Name, s.FileName)
+}
+
+type UnreadableFile struct {
+	Name string
+	Path string
+	Err  error
+}
+
+func NewUnreadableFile(name, path string, err error) *UnreadableFile {
+	return &UnreadableFile{
+		Name: name,
+		Path: path,
+		Err:  err,
+	}
+}
+
+func (s UnreadableFile) Error() string {
+	return fmt.Sprintf("can't read %v from %v: %v", s.Name, s.Path, s.Err)
+}
+
+func (s UnreadableFile) Unwrap() error {
+	return s.Err
+}
+
+type UnknownVariable struct {
+	Name       string
+	Suggestion string
+}
+
+func NewUnknownVariable(name, suggestion string) *UnknownVariable {
+	return &UnknownVariable{
+		Name:       name,
+		Suggestion: suggestion,
+	}
+}
+
+func (s UnknownVariable) Error() string {
+	msg := fmt.Sprintf("unknown variable %v", s.Name)
+
+	if s.Suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %v?", s.Suggestion)
+	}
+
+	return msg
+}
@@ -0,0 +1,76 @@
+package yacl
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ValidationError struct {
+	FieldPath []string
+	Err       error
+}
+
+func NewValidationError(fieldPath []string, err error) *ValidationError {
+	return &ValidationError{
+		FieldPath: fieldPath,
+		Err:       err,
+	}
+}
+
+func (s ValidationError) Error() string {
+	if len(s.FieldPath) == 0 {
+		return fmt.Sprintf("config validation failed: %v", s.Err)
+	}
+
+	return fmt.Sprintf("config validation failed at %s: %v", strings.Join(s.FieldPath, "."), s.Err)
+}
+
+func (s ValidationError) Unwrap() error {
+	return s.Err
+}
+
+type UnknownReference struct {
+	FieldPath []string
+	Reference string
+}
+
+func NewUnknownReference(fieldPath []string, reference string) *UnknownReference {
+	return &UnknownReference{
+		FieldPath: fieldPath,
+		Reference: reference,
+	}
+}
+
+func (s UnknownReference) Error() string {
+	return fmt.Sprintf("%s refers to unknown field ${%s}", strings.Join(s.FieldPath, "."), s.Reference)
+}
+
+type SecretReference struct {
+	FieldPath []string
+	Reference string
+}
+
+func NewSecretReference(fieldPath []string, reference string) *SecretReference {
+	return &SecretReference{
+		FieldPath: fieldPath,
+		Reference: reference,
+	}
+}
+
+func (s SecretReference) Error() string {
+	return fmt.Sprintf("%s refers to secret field ${%s} without being secret", strings.Join(s.FieldPath, "."), s.Reference)
+}
+
+type ReferenceCycle struct {
+	FieldPaths []string
+}
+
+func NewReferenceCycle(fieldPaths []string) *ReferenceCycle {
+	return &ReferenceCycle{
+		FieldPaths: fieldPaths,
+	}
+}
+
+func (s ReferenceCycle) Error() string {
+	return fmt.Sprintf("reference cycle: %s", strings.Join(s.FieldPaths, " -> "))
+}
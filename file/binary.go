@@ -1,41 +1,53 @@
 package file
 
 import (
+	"bytes"
 	"os"
-	"path/filepath"
+	"reflect"
 
 	"github.com/vmihailenco/msgpack/v5"
 )
 
+var binaryUnmarshalerType = reflect.TypeOf((*msgpack.CustomDecoder)(nil)).Elem()
+
 func ParseBinary[T any](params *Params) (*T, error) {
 	var cfg T
-	filename := params.Filename + ".bin"
 
-	for _, path := range params.Paths {
-		fullPath := filepath.Join(path, filename)
+	fullPath, err := Lookup(params, params.Filename+BinaryExtension)
+	if err != nil {
+		return nil, err
+	}
 
-		_, err := os.Stat(fullPath)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue // try next path
-			}
+	binaryFile, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, err // some other error occurred
-		}
+	var tree any
 
-		yamlFile, err := os.ReadFile(fullPath)
-		if err != nil {
-			return nil, err
-		}
+	if err = msgpack.Unmarshal(binaryFile, &tree); err != nil {
+		return nil, err
+	}
 
-		if err = msgpack.Unmarshal(yamlFile, &cfg); err != nil {
+	if params.Strict {
+		if err = checkBinaryKeys(params, fullPath, tree, reflect.TypeOf(cfg), ""); err != nil {
 			return nil, err
 		}
+	}
 
-		return &cfg, nil
+	binaryFile, err = msgpack.Marshal(renameKeys(tree, reflect.TypeOf(cfg), binaryUnmarshalerType, false, func(t reflect.Type) *fileFields {
+		return binaryFields(params, t)
+	}))
+	if err != nil {
+		return nil, err
 	}
 
-	err := NewNotFound(filename, params.Paths)
+	decoder := msgpack.NewDecoder(bytes.NewReader(binaryFile))
+	decoder.DisallowUnknownFields(params.Strict)
+
+	if err = decoder.Decode(&cfg); err != nil {
+		return nil, err
+	}
 
-	return nil, err
+	return &cfg, nil
 }
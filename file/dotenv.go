@@ -0,0 +1,205 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const DotenvFilename = ".env"
+
+// ParseDotenv reads variables from the first .env file found in params.Paths.
+func ParseDotenv(params *Params) ([]string, error) {
+	fullPath, err := Lookup(params, DotenvFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	dotenvFile, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &dotenvParser{data: string(dotenvFile), line: 1}
+
+	vars, err := parser.parse()
+	if err != nil {
+		return nil, NewDotenvSyntaxError(fullPath, parser.line, err.Error())
+	}
+
+	return vars, nil
+}
+
+type dotenvParser struct {
+	data string
+	pos  int
+	line int
+}
+
+func (s *dotenvParser) parse() ([]string, error) {
+	vars := make([]string, 0)
+
+	for {
+		s.skip(" \t\r\n")
+
+		if s.eof() {
+			return vars, nil
+		}
+
+		if s.peek() == '#' {
+			s.skipLine()
+			continue
+		}
+
+		if strings.HasPrefix(s.data[s.pos:], "export ") || strings.HasPrefix(s.data[s.pos:], "export\t") {
+			s.pos += len("export")
+			s.skip(" \t")
+		}
+
+		key := s.readKey()
+		if key == "" {
+			return nil, fmt.Errorf("variable name expected")
+		}
+
+		s.skip(" \t")
+
+		if s.eof() || s.peek() != '=' {
+			return nil, fmt.Errorf("= expected after %v", key)
+		}
+
+		s.pos++
+		s.skip(" \t")
+
+		value, err := s.readValue()
+		if err != nil {
+			return nil, err
+		}
+
+		vars = append(vars, key+"="+value)
+	}
+}
+
+func (s *dotenvParser) readKey() string {
+	start := s.pos
+
+	for !s.eof() {
+		c := s.peek()
+		if !(c == '_' || c == '.' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			break
+		}
+
+		s.pos++
+	}
+
+	return s.data[start:s.pos]
+}
+
+func (s *dotenvParser) readValue() (string, error) {
+	if s.eof() {
+		return "", nil
+	}
+
+	switch quote := s.peek(); quote {
+	case '\'', '"':
+		value, err := s.readQuoted(quote)
+		if err != nil {
+			return "", err
+		}
+
+		// Only a comment may follow the closing quote
+		s.skip(" \t\r")
+		if !s.eof() && s.peek() != '\n' && s.peek() != '#' {
+			return "", fmt.Errorf("unexpected %q after quoted value", s.peek())
+		}
+
+		s.skipLine()
+
+		return value, nil
+
+	default:
+		end := strings.IndexByte(s.data[s.pos:], '\n')
+		if end < 0 {
+			end = len(s.data) - s.pos
+		}
+
+		value := s.data[s.pos : s.pos+end]
+		s.pos += end
+
+		// Inline comments must be separated by whitespace, e.g. URL#anchor is a value
+		for i := 0; i < len(value); i++ {
+			if value[i] == '#' && (i == 0 || value[i-1] == ' ' || value[i-1] == '\t') {
+				value = value[:i]
+				break
+			}
+		}
+
+		return strings.TrimSpace(value), nil
+	}
+}
+
+func (s *dotenvParser) readQuoted(quote byte) (string, error) {
+	var value strings.Builder
+
+	s.pos++ // opening quote
+
+	for !s.eof() {
+		c := s.peek()
+		s.pos++
+
+		switch {
+		case c == quote:
+			return value.String(), nil
+
+		case c == '\\' && quote == '"' && !s.eof():
+			escaped := s.peek()
+			s.pos++
+
+			switch escaped {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case 'r':
+				value.WriteByte('\r')
+			case '"', '\\', '$':
+				value.WriteByte(escaped)
+			default:
+				value.WriteByte('\\')
+				value.WriteByte(escaped)
+			}
+
+		default:
+			if c == '\n' {
+				s.line++
+			}
+
+			value.WriteByte(c)
+		}
+	}
+
+	return "", fmt.Errorf("unterminated %c quoted value", quote)
+}
+
+func (s *dotenvParser) skip(chars string) {
+	for !s.eof() && strings.IndexByte(chars, s.peek()) >= 0 {
+		if s.peek() == '\n' {
+			s.line++
+		}
+
+		s.pos++
+	}
+}
+
+func (s *dotenvParser) skipLine() {
+	for !s.eof() && s.peek() != '\n' {
+		s.pos++
+	}
+}
+
+func (s *dotenvParser) peek() byte {
+	return s.data[s.pos]
+}
+
+func (s *dotenvParser) eof() bool {
+	return s.pos >= len(s.data)
+}
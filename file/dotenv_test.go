@@ -0,0 +1,77 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDotenv(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+		line     int
+	}{
+		{
+			name: "complete",
+			content: `# database settings
+DATABASE_HOSTNAME=localhost
+export DATABASE_PORT = 5432   # inline comment
+HOMEPAGE=https://example.com/#about
+
+SINGLE='literal \n $value # not a comment'
+DOUBLE="tab\there \"quoted\""
+MULTILINE="first
+second"
+EMPTY=
+COMMENTED= # comment
+`,
+			expected: []string{
+				"DATABASE_HOSTNAME=localhost",
+				"DATABASE_PORT=5432",
+				"HOMEPAGE=https://example.com/#about",
+				`SINGLE=literal \n $value # not a comment`,
+				"DOUBLE=tab\there \"quoted\"",
+				"MULTILINE=first\nsecond",
+				"EMPTY=",
+				"COMMENTED=",
+			},
+		}, {
+			name:     "no-trailing-newline",
+			content:  "KEY=value",
+			expected: []string{"KEY=value"},
+		}, {
+			name:    "missing-equals",
+			content: "FIRST=1\nSECOND\n",
+			line:    2,
+		}, {
+			name:    "unterminated-quote",
+			content: "FIRST=1\nSECOND=\"value\n\n",
+			line:    4,
+		}, {
+			name:    "garbage-after-quote",
+			content: "FIRST='value' garbage\n",
+			line:    1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			assert.NoError(t, os.WriteFile(filepath.Join(tempDir, DotenvFilename), []byte(tc.content), 0644))
+
+			vars, err := ParseDotenv(DefaultParams(tempDir))
+			if tc.line > 0 {
+				var syntaxErr *DotenvSyntaxError
+				assert.ErrorAs(t, err, &syntaxErr)
+				assert.Equal(t, tc.line, syntaxErr.Line)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, vars)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package file
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type NotFound struct {
 	Filename string
@@ -17,3 +20,81 @@ func NewNotFound(filename string, paths []string) *NotFound {
 func (s NotFound) Error() string {
 	return fmt.Sprintf("%v not found in paths: %v", s.Filename, s.Paths)
 }
+
+type DotenvSyntaxError struct {
+	Path    string
+	Line    int
+	Message string
+}
+
+func NewDotenvSyntaxError(path string, line int, message string) *DotenvSyntaxError {
+	return &DotenvSyntaxError{
+		Path:    path,
+		Line:    line,
+		Message: message,
+	}
+}
+
+func (s DotenvSyntaxError) Error() string {
+	return fmt.Sprintf("%v:%v: %v", s.Path, s.Line, s.Message)
+}
+
+type UndefinedVariables struct {
+	Path  string
+	Names []string
+}
+
+func NewUndefinedVariables(path string, names []string) *UndefinedVariables {
+	return &UndefinedVariables{
+		Path:  path,
+		Names: names,
+	}
+}
+
+func (s UndefinedVariables) Error() string {
+	return fmt.Sprintf("%v: undefined variables without defaults: %v", s.Path, strings.Join(s.Names, ", "))
+}
+
+type UnknownKey struct {
+	Path       string
+	Line       int
+	Key        string
+	Suggestion string
+}
+
+func NewUnknownKey(path string, line int, key, suggestion string) *UnknownKey {
+	return &UnknownKey{
+		Path:       path,
+		Line:       line,
+		Key:        key,
+		Suggestion: suggestion,
+	}
+}
+
+func (s UnknownKey) Error() string {
+	msg := fmt.Sprintf("%v:%v: unknown key %v", s.Path, s.Line, s.Key)
+
+	if s.Suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %v?", s.Suggestion)
+	}
+
+	return msg
+}
+
+type ExcludedKey struct {
+	Path string
+	Line int
+	Key  string
+}
+
+func NewExcludedKey(path string, line int, key string) *ExcludedKey {
+	return &ExcludedKey{
+		Path: path,
+		Line: line,
+		Key:  key,
+	}
+}
+
+func (s ExcludedKey) Error() string {
+	return fmt.Sprintf("%v:%v: key %v is excluded from config files", s.Path, s.Line, s.Key)
+}
@@ -2,14 +2,46 @@ package file
 
 import (
 	"os"
+	"path/filepath"
+
+	"github.com/andrew528i/yacl/utils"
 )
 
 var DefaultFilename = "config"
 var DefaultPath = ""
 
+const (
+	YAMLExtension   = ".yaml"
+	JSONExtension   = ".json"
+	BinaryExtension = ".bin"
+)
+
+// DefaultInterpolate is off, since existing files may hold literal ${...} values.
+var DefaultInterpolate = false
+var DefaultDotenv = false
+var DefaultStrict = false
+
+// DefaultNaming makes keys of fields in config files.
+var DefaultNaming = utils.SnakeCase
+
 type Params struct {
 	Paths    []string
 	Filename string
+
+	// Interpolate enables expansion of ${VAR} and ${VAR:-default} in values.
+	Interpolate bool
+
+	// Dotenv enables reading variables from the .env file.
+	Dotenv bool
+
+	// Naming makes keys of fields without keys set by tags.
+	Naming utils.NamingStrategy
+
+	// Strict makes files with keys which don't match any field fail to parse.
+	Strict bool
+
+	// Environ holds variables for the interpolation, os.Environ if nil.
+	Environ []string
 }
 
 func DefaultParams(extraPaths ...string) *Params {
@@ -28,7 +60,46 @@ func DefaultParams(extraPaths ...string) *Params {
 	}
 
 	return &Params{
-		Paths:    paths,
-		Filename: DefaultFilename,
+		Paths:       paths,
+		Filename:    DefaultFilename,
+		Interpolate: DefaultInterpolate,
+		Dotenv:      DefaultDotenv,
+		Strict:      DefaultStrict,
+		Naming:      DefaultNaming,
 	}
 }
+
+// Filenames returns names of all the config files YACL looks for.
+func Filenames(params *Params) []string {
+	filenames := []string{
+		params.Filename + YAMLExtension,
+		params.Filename + JSONExtension,
+		params.Filename + BinaryExtension,
+	}
+
+	if params.Dotenv {
+		filenames = append(filenames, DotenvFilename)
+	}
+
+	return filenames
+}
+
+// Lookup returns the full path of filename in the first path containing it.
+func Lookup(params *Params, filename string) (string, error) {
+	for _, path := range params.Paths {
+		fullPath := filepath.Join(path, filename)
+
+		_, err := os.Stat(fullPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue // try next path
+			}
+
+			return "", err // some other error occurred
+		}
+
+		return fullPath, nil
+	}
+
+	return "", NewNotFound(filename, params.Paths)
+}
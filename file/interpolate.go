@@ -0,0 +1,113 @@
+package file
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/andrew528i/yacl/utils"
+	"gopkg.in/yaml.v3"
+)
+
+var interpolationRegexp = regexp.MustCompile(`\$?\$\{([^}]*)\}`)
+
+// interpolator expands ${NAME} and ${NAME:-default} in values of parsed files.
+type interpolator struct {
+	vars      map[string]string
+	undefined []string
+}
+
+func newInterpolator(environ []string) *interpolator {
+	return &interpolator{vars: utils.EnvironMap(environ)}
+}
+
+func (s *interpolator) expand(value string) string {
+	return interpolationRegexp.ReplaceAllStringFunc(value, func(expr string) string {
+		name, defaultValue, hasDefault := strings.Cut(expr[strings.Index(expr, "{")+1:len(expr)-1], ":-")
+
+		if strings.Contains(name, ".") {
+			return expr
+		}
+
+		if strings.HasPrefix(expr, "$$") {
+			return expr[1:]
+		}
+
+		value, ok := s.vars[name]
+		if ok && value != "" {
+			return value
+		}
+
+		if hasDefault {
+			return defaultValue
+		}
+
+		if !ok {
+			for _, v := range s.undefined {
+				if v == name {
+					return expr
+				}
+			}
+
+			s.undefined = append(s.undefined, name)
+
+			return expr
+		}
+
+		return value
+	})
+}
+
+// expandYAML resolves plain scalars again so ${PORT} decodes into numbers.
+func (s *interpolator) expandYAML(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, item := range node.Content {
+			s.expandYAML(item)
+		}
+
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			s.expandYAML(node.Content[i])
+		}
+
+	case yaml.ScalarNode:
+		if node.ShortTag() != "!!str" {
+			return
+		}
+
+		if value := s.expand(node.Value); value != node.Value {
+			node.Value = value
+
+			if node.Style == 0 {
+				node.Tag = ""
+			}
+		}
+	}
+}
+
+func (s *interpolator) expandTree(tree any) any {
+	switch tree := tree.(type) {
+	case string:
+		return s.expand(tree)
+
+	case map[string]any:
+		for key, value := range tree {
+			tree[key] = s.expandTree(value)
+		}
+
+	case []any:
+		for i, item := range tree {
+			tree[i] = s.expandTree(item)
+		}
+	}
+
+	return tree
+}
+
+func (s *interpolator) err(fullPath string) error {
+	if len(s.undefined) > 0 {
+		return NewUndefinedVariables(fullPath, s.undefined)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpolate(t *testing.T) {
+	environ := []string{"DB_HOST=db.local", "EMPTY="}
+
+	testCases := []struct {
+		name      string
+		input     string
+		expected  string
+		undefined []string
+	}{
+		{
+			name:     "variable",
+			input:    "host: ${DB_HOST}",
+			expected: "host: db.local",
+		}, {
+			name:     "default",
+			input:    "port: ${DB_PORT:-5432}, host: ${DB_HOST:-localhost}, empty: ${EMPTY:-fallback}",
+			expected: "port: 5432, host: db.local, empty: fallback",
+		}, {
+			name:     "empty",
+			input:    "empty: '${EMPTY}'",
+			expected: "empty: ''",
+		}, {
+			name:     "escape",
+			input:    "literal: $${DB_HOST}",
+			expected: "literal: ${DB_HOST}",
+		}, {
+			name:     "field-reference",
+			input:    "url: https://${server.host}:$${server.port}",
+			expected: "url: https://${server.host}:$${server.port}",
+		}, {
+			name:      "undefined",
+			input:     "user: ${DB_USER}, password: ${DB_PASSWORD}, again: ${DB_USER}",
+			undefined: []string{"DB_USER", "DB_PASSWORD"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator := newInterpolator(environ)
+			result := interpolator.expand(tc.input)
+			err := interpolator.err("config.yaml")
+
+			if tc.undefined != nil {
+				var undefinedErr *UndefinedVariables
+				assert.ErrorAs(t, err, &undefinedErr)
+				assert.Equal(t, tc.undefined, undefinedErr.Names)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseYAML_Interpolate(t *testing.T) {
+	type Config struct {
+		Hostname string `yaml:"hostname"`
+		Port     uint   `yaml:"port"`
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("hostname: ${DB_HOST}\nport: ${DB_PORT:-5432}\n"), 0644))
+
+	params := DefaultParams(tempDir)
+	params.Interpolate = true
+	params.Environ = []string{"DB_HOST=db.local"}
+	cfg, err := ParseYAML[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Hostname: "db.local", Port: 5432}, *cfg)
+
+	params.Interpolate = false
+	_, err = ParseYAML[Config](params)
+	assert.Error(t, err) // ${DB_PORT:-5432} is not a number
+}
+
+func TestParse_InterpolateValues(t *testing.T) {
+	type Config struct {
+		Message string
+		Port    uint
+	}
+
+	environ := []string{"MESSAGE=say \"hi\"\n  key: value", "PORT=8080"}
+	expected := &Config{Message: "say \"hi\"\n  key: value", Port: 8080}
+
+	tempDir := t.TempDir()
+	yamlConfig := "# ${UNDEFINED} in comments is ignored\nmessage: ${MESSAGE} # ${UNDEFINED}\nport: ${PORT}\n"
+	jsonConfig := `{"message": "${MESSAGE}", "port": 8080}`
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte(yamlConfig), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.json"), []byte(jsonConfig), 0644))
+
+	params := DefaultParams(tempDir)
+	params.Interpolate = true
+	params.Environ = environ
+
+	yamlCfg, err := ParseYAML[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, yamlCfg)
+
+	jsonCfg, err := ParseJSON[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, jsonCfg)
+}
@@ -1,40 +1,64 @@
 package file
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
-	"path/filepath"
+	"reflect"
 )
 
 func ParseJSON[T any](params *Params) (*T, error) {
 	var cfg T
-	filename := params.Filename + ".json"
 
-	for _, path := range params.Paths {
-		fullPath := filepath.Join(path, filename)
+	fullPath, err := Lookup(params, params.Filename+JSONExtension)
+	if err != nil {
+		return nil, err
+	}
 
-		_, err := os.Stat(fullPath)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue // try next path
-			}
+	jsonFile, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, err // some other error occurred
-		}
+	var tree any
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonFile))
+	decoder.UseNumber()
+
+	if err = decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
 
-		yamlFile, err := os.ReadFile(fullPath)
-		if err != nil {
+	if params.Interpolate {
+		interpolator := newInterpolator(params.Environ)
+		tree = interpolator.expandTree(tree)
+
+		if err = interpolator.err(fullPath); err != nil {
 			return nil, err
 		}
+	}
 
-		if err = json.Unmarshal(yamlFile, &cfg); err != nil {
+	if params.Strict {
+		if err = checkJSONKeys(params, fullPath, jsonFile, reflect.TypeOf(cfg)); err != nil {
 			return nil, err
 		}
+	}
 
-		return &cfg, nil
+	jsonFile, err = json.Marshal(renameKeys(tree, reflect.TypeOf(cfg), jsonUnmarshalerType, true, func(t reflect.Type) *fileFields {
+		return jsonFields(params, t)
+	}))
+	if err != nil {
+		return nil, err
 	}
 
-	err := NewNotFound(filename, params.Paths)
+	decoder = json.NewDecoder(bytes.NewReader(jsonFile))
+	if params.Strict {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err = decoder.Decode(&cfg); err != nil {
+		return nil, err
+	}
 
-	return nil, err
+	return &cfg, nil
 }
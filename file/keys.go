@@ -0,0 +1,260 @@
+package file
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/andrew528i/yacl/utils"
+)
+
+// fileField is a struct field matched by a key of config files.
+type fileField struct {
+	key      string
+	typ      reflect.Type
+	excluded bool
+}
+
+// fileFields maps keys of a struct to its fields.
+type fileFields struct {
+	fields    map[string]fileField
+	preferred []string
+	rest      reflect.Type
+}
+
+func newFileFields() *fileFields {
+	return &fileFields{fields: make(map[string]fileField)}
+}
+
+func (s *fileFields) add(key string, field fileField, preferred bool) {
+	if _, ok := s.fields[key]; ok {
+		return
+	}
+
+	s.fields[key] = field
+
+	if preferred && !field.excluded {
+		s.preferred = append(s.preferred, key)
+	}
+}
+
+// closest returns the preferred key closest to key.
+func (s *fileFields) closest(key string) string {
+	keys := make([]string, len(s.preferred))
+	copy(keys, s.preferred)
+	sort.Strings(keys)
+
+	return utils.Closest(key, keys)
+}
+
+// yamlFields maps keys to fields following yaml.v3 rules.
+func yamlFields(params *Params, t reflect.Type) *fileFields {
+	fields := newFileFields()
+	addYAMLFields(params, t, fields, false)
+
+	return fields
+}
+
+func addYAMLFields(params *Params, t reflect.Type, fields *fileFields, excluded bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := utils.TagName(field.Tag, "yaml")
+		if name == "-" {
+			continue
+		}
+
+		fieldExcluded := excluded || !utils.FromSource([]reflect.StructField{field}, utils.SourceFile)
+
+		if utils.HasTagOption(field.Tag, "yaml", "inline") {
+			switch indirect(field.Type).Kind() {
+			case reflect.Struct:
+				addYAMLFields(params, indirect(field.Type), fields, fieldExcluded)
+
+			case reflect.Map:
+				fields.rest = field.Type
+			}
+
+			continue
+		}
+
+		native := name
+		if native == "" {
+			native = strings.ToLower(field.Name)
+		}
+
+		f := fileField{key: native, typ: field.Type, excluded: fieldExcluded}
+		fields.add(yamlKey(params, field), f, true)
+		fields.add(native, f, false)
+	}
+}
+
+// yamlKey returns the preferred key of the field in YAML files.
+func yamlKey(params *Params, field reflect.StructField) string {
+	if name := utils.TagName(field.Tag, "yaml"); name != "" {
+		return name
+	}
+
+	if name := utils.TagName(field.Tag, "key"); name != "" {
+		return name
+	}
+
+	return params.Naming.Name(utils.SplitWords(field.Name), "")
+}
+
+// jsonFields maps keys to fields following encoding/json rules.
+func jsonFields(params *Params, t reflect.Type) *fileFields {
+	fields := newFileFields()
+	addTaggedFields(params, t, "json", fields, false)
+
+	return fields
+}
+
+// binaryFields maps keys to fields following msgpack rules.
+func binaryFields(params *Params, t reflect.Type) *fileFields {
+	fields := newFileFields()
+	addTaggedFields(params, t, "msgpack", fields, false)
+
+	return fields
+}
+
+func addTaggedFields(params *Params, t reflect.Type, tagName string, fields *fileFields, excluded bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name := utils.TagName(field.Tag, tagName)
+		if name == "-" {
+			continue
+		}
+
+		fieldExcluded := excluded || !utils.FromSource([]reflect.StructField{field}, utils.SourceFile)
+
+		if field.Anonymous && name == "" && indirect(field.Type).Kind() == reflect.Struct {
+			addTaggedFields(params, indirect(field.Type), tagName, fields, fieldExcluded)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		native := name
+		if native == "" {
+			native = field.Name
+		}
+
+		preferred := name
+		if preferred == "" {
+			preferred = utils.TagName(field.Tag, "key")
+		}
+
+		if preferred == "" {
+			preferred = params.Naming.Name(utils.SplitWords(field.Name), "")
+		}
+
+		f := fileField{key: native, typ: field.Type, excluded: fieldExcluded}
+		fields.add(preferred, f, true)
+		fields.add(native, f, false)
+	}
+}
+
+func (s *fileFields) lookup(key string, fold bool) (fileField, bool) {
+	if field, ok := s.fields[key]; ok {
+		return field, true
+	}
+
+	if fold {
+		for name, field := range s.fields {
+			if strings.EqualFold(name, key) {
+				return field, true
+			}
+		}
+	}
+
+	return fileField{}, false
+}
+
+// Key returns the path of keys the last of fields is read from in YAML files.
+func Key(params *Params, fields []reflect.StructField) string {
+	keyPath := ""
+
+	for _, field := range fields {
+		if utils.TagName(field.Tag, "yaml") == "-" || !utils.FromSource([]reflect.StructField{field}, utils.SourceFile) {
+			return ""
+		}
+
+		if !utils.HasTagOption(field.Tag, "yaml", "inline") {
+			keyPath = joinKeyPath(keyPath, yamlKey(params, field))
+		}
+	}
+
+	return keyPath
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func joinKeyPath(keyPath, key string) string {
+	if keyPath == "" {
+		return key
+	}
+
+	return keyPath + "." + key
+}
+
+// renameKeys renames keys of tree to the ones the decoding library expects.
+func renameKeys(tree any, t reflect.Type, unmarshaler reflect.Type, fold bool, fieldsOf func(reflect.Type) *fileFields) any {
+	t = indirect(t)
+
+	if reflect.PointerTo(t).Implements(unmarshaler) {
+		return tree
+	}
+
+	switch tree := tree.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := fieldsOf(t)
+			renamed := make(map[string]any, len(tree))
+
+			for key, value := range tree {
+				field, ok := fields.lookup(key, fold)
+
+				switch {
+				case ok && field.excluded:
+					continue
+
+				case ok:
+					renamed[field.key] = renameKeys(value, field.typ, unmarshaler, fold, fieldsOf)
+
+				default:
+					renamed[key] = value
+				}
+			}
+
+			return renamed
+
+		case reflect.Map:
+			for key, value := range tree {
+				tree[key] = renameKeys(value, t.Elem(), unmarshaler, fold, fieldsOf)
+			}
+		}
+
+	case []any:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, item := range tree {
+				tree[i] = renameKeys(item, t.Elem(), unmarshaler, fold, fieldsOf)
+			}
+		}
+	}
+
+	return tree
+}
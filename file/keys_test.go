@@ -0,0 +1,176 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/andrew528i/yacl/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestParse_Naming(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname   string
+		MaxConns   int
+		CanRestart bool
+	}
+
+	type Config struct {
+		Database DatabaseConfig
+		Replicas []DatabaseConfig
+		Labels   map[string]string
+		Timeout  *int
+		Username string `yaml:"user" json:"user"`
+		Password string `key:"pass"`
+	}
+
+	timeout := 30
+	expected := &Config{
+		Database: DatabaseConfig{Hostname: "localhost", MaxConns: 10, CanRestart: true},
+		Replicas: []DatabaseConfig{{Hostname: "replica", CanRestart: true}},
+		Labels:   map[string]string{"env_name": "prod"},
+		Timeout:  &timeout,
+		Username: "admin",
+		Password: "secret",
+	}
+
+	testCases := []struct {
+		name     string
+		filename string
+		content  string
+		naming   utils.NamingStrategy
+	}{
+		{
+			name:     "yaml-snake",
+			filename: "config.yaml",
+			content: "database:\n  hostname: localhost\n  max_conns: 10\n  can_restart: true\n" +
+				"replicas:\n  - hostname: replica\n    can_restart: true\n" +
+				"labels:\n  env_name: prod\ntimeout: 30\nuser: admin\npass: secret\n",
+		}, {
+			name:     "yaml-native",
+			filename: "config.yaml",
+			content: "database:\n  hostname: localhost\n  maxconns: 10\n  canrestart: true\n" +
+				"replicas:\n  - hostname: replica\n    canrestart: true\n" +
+				"labels:\n  env_name: prod\ntimeout: 30\nuser: admin\npass: secret\n",
+		}, {
+			name:     "yaml-kebab",
+			filename: "config.yaml",
+			content: "database:\n  hostname: localhost\n  max-conns: 10\n  can-restart: true\n" +
+				"replicas:\n  - hostname: replica\n    can-restart: true\n" +
+				"labels:\n  env_name: prod\ntimeout: 30\nuser: admin\npass: secret\n",
+			naming: utils.KebabCase,
+		}, {
+			name:     "json-snake",
+			filename: "config.json",
+			content: `{"database": {"hostname": "localhost", "max_conns": 10, "can_restart": true},
+				"replicas": [{"hostname": "replica", "can_restart": true}],
+				"labels": {"env_name": "prod"}, "timeout": 30, "user": "admin", "pass": "secret"}`,
+		}, {
+			name:     "json-native",
+			filename: "config.json",
+			content: `{"Database": {"Hostname": "localhost", "MaxConns": 10, "CanRestart": true},
+				"Replicas": [{"Hostname": "replica", "CanRestart": true}],
+				"Labels": {"env_name": "prod"}, "Timeout": 30, "user": "admin", "pass": "secret"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			assert.NoError(t, os.WriteFile(filepath.Join(tempDir, tc.filename), []byte(tc.content), 0644))
+
+			params := DefaultParams(tempDir)
+			params.Strict = true
+
+			if tc.naming != nil {
+				params.Naming = tc.naming
+			}
+
+			var cfg *Config
+			var err error
+
+			if filepath.Ext(tc.filename) == YAMLExtension {
+				cfg, err = ParseYAML[Config](params)
+			} else {
+				cfg, err = ParseJSON[Config](params)
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	type Common struct {
+		LogLevel string `key:"log"`
+	}
+
+	type Config struct {
+		Common   `yaml:",inline"`
+		Database struct {
+			MaxConns int
+			Password string `yaml:"-"`
+		} `yaml:"db"`
+	}
+
+	typ := reflect.TypeOf(Config{})
+	common := typ.Field(0)
+	database := typ.Field(1)
+
+	assert.Equal(t, "log", Key(DefaultParams(), []reflect.StructField{common, common.Type.Field(0)}))
+	assert.Equal(t, "db.max_conns", Key(DefaultParams(), []reflect.StructField{database, database.Type.Field(0)}))
+	assert.Equal(t, "", Key(DefaultParams(), []reflect.StructField{database, database.Type.Field(1)}))
+}
+
+func TestParse_Embedded(t *testing.T) {
+	type Common struct {
+		LogLevel string
+	}
+
+	type Config struct {
+		*Common
+		Extra map[string]string `yaml:",inline"`
+		Port  int
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.json"), []byte(`{"log_level": "debug", "port": 8080}`), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("port: 8080\nregion: eu\n"), 0644))
+
+	params := DefaultParams(tempDir)
+	params.Strict = true
+
+	jsonCfg, err := ParseJSON[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{Common: &Common{LogLevel: "debug"}, Port: 8080}, jsonCfg)
+
+	yamlCfg, err := ParseYAML[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{Extra: map[string]string{"region": "eu"}, Port: 8080}, yamlCfg)
+}
+
+func TestParseBinary_Naming(t *testing.T) {
+	type Config struct {
+		MaxConns int
+		Password string `yacl:",nofile"`
+	}
+
+	tempDir := t.TempDir()
+
+	data, err := msgpack.Marshal(map[string]any{"max_conns": 10, "Password": "secret"})
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.bin"), data, 0644))
+
+	params := DefaultParams(tempDir)
+	cfg, err := ParseBinary[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{MaxConns: 10}, cfg)
+
+	params.Strict = true
+	_, err = ParseBinary[Config](params)
+	assert.Equal(t, NewExcludedKey(filepath.Join(tempDir, "config.bin"), 0, "Password"), err)
+}
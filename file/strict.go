@@ -0,0 +1,265 @@
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	yamlUnmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// checkYAMLKeys returns the first key of the document not matching a field.
+func checkYAMLKeys(params *Params, fullPath string, document *yaml.Node, t reflect.Type) error {
+	if len(document.Content) == 0 {
+		return nil
+	}
+
+	return checkYAMLNode(params, fullPath, document.Content[0], t, "")
+}
+
+func checkYAMLNode(params *Params, fullPath string, node *yaml.Node, t reflect.Type, keyPath string) error {
+	t = indirect(t)
+
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if reflect.PointerTo(t).Implements(yamlUnmarshalerType) {
+		return nil
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && node.Kind == yaml.MappingNode:
+		fields := yamlFields(params, t)
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if key.ShortTag() == "!!merge" {
+				if err := checkYAMLMerge(params, fullPath, value, t, keyPath); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			valueType := fields.rest
+			if valueType != nil {
+				valueType = indirect(valueType).Elem()
+			}
+
+			field, ok := fields.lookup(key.Value, false)
+
+			switch {
+			case ok && field.excluded:
+				return NewExcludedKey(fullPath, key.Line, joinKeyPath(keyPath, key.Value))
+
+			case ok:
+				valueType = field.typ
+
+			case valueType == nil:
+				return NewUnknownKey(fullPath, key.Line, joinKeyPath(keyPath, key.Value), fields.closest(key.Value))
+			}
+
+			if err := checkYAMLNode(params, fullPath, value, valueType, joinKeyPath(keyPath, key.Value)); err != nil {
+				return err
+			}
+		}
+
+	case t.Kind() == reflect.Map && node.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if err := checkYAMLNode(params, fullPath, node.Content[i+1], t.Elem(), joinKeyPath(keyPath, node.Content[i].Value)); err != nil {
+				return err
+			}
+		}
+
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && node.Kind == yaml.SequenceNode:
+		for i, item := range node.Content {
+			if err := checkYAMLNode(params, fullPath, item, t.Elem(), fmt.Sprintf("%s[%d]", keyPath, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkYAMLMerge checks mappings merged by <<: *alias into the struct.
+func checkYAMLMerge(params *Params, fullPath string, node *yaml.Node, t reflect.Type, keyPath string) error {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		return checkYAMLNode(params, fullPath, node, t, keyPath)
+	}
+
+	for _, item := range node.Content {
+		if err := checkYAMLNode(params, fullPath, item, t, keyPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkJSONKeys returns the first key of data not matching a field.
+func checkJSONKeys(params *Params, fullPath string, data []byte, t reflect.Type) error {
+	checker := &jsonKeyChecker{
+		params:   params,
+		fullPath: fullPath,
+		data:     data,
+		decoder:  json.NewDecoder(bytes.NewReader(data)),
+	}
+
+	return checker.check(t, "")
+}
+
+type jsonKeyChecker struct {
+	params   *Params
+	fullPath string
+	data     []byte
+	decoder  *json.Decoder
+}
+
+func (s *jsonKeyChecker) check(t reflect.Type, keyPath string) error {
+	token, err := s.decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return nil // scalar
+	}
+
+	if t != nil {
+		t = indirect(t)
+
+		if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+			t = nil
+		}
+	}
+
+	switch delim {
+	case '{':
+		var fields *fileFields
+
+		if t != nil && t.Kind() == reflect.Struct {
+			fields = jsonFields(s.params, t)
+		}
+
+		for s.decoder.More() {
+			token, err = s.decoder.Token()
+			if err != nil {
+				return err
+			}
+
+			key := token.(string)
+
+			var valueType reflect.Type
+
+			switch {
+			case fields != nil:
+				field, ok := fields.lookup(key, true)
+				if !ok {
+					return NewUnknownKey(s.fullPath, s.line(), joinKeyPath(keyPath, key), fields.closest(key))
+				}
+
+				if field.excluded {
+					return NewExcludedKey(s.fullPath, s.line(), joinKeyPath(keyPath, key))
+				}
+
+				valueType = field.typ
+
+			case t != nil && t.Kind() == reflect.Map:
+				valueType = t.Elem()
+			}
+
+			if err = s.check(valueType, joinKeyPath(keyPath, key)); err != nil {
+				return err
+			}
+		}
+
+	case '[':
+		for i := 0; s.decoder.More(); i++ {
+			var elemType reflect.Type
+
+			if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+				elemType = t.Elem()
+			}
+
+			if err = s.check(elemType, fmt.Sprintf("%s[%d]", keyPath, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = s.decoder.Token() // closing delimiter
+
+	return err
+}
+
+// line returns the line of the last token read.
+func (s *jsonKeyChecker) line() int {
+	return bytes.Count(s.data[:s.decoder.InputOffset()], []byte("\n")) + 1
+}
+
+// checkBinaryKeys returns the first key of a field excluded from files.
+func checkBinaryKeys(params *Params, fullPath string, tree any, t reflect.Type, keyPath string) error {
+	t = indirect(t)
+
+	if reflect.PointerTo(t).Implements(binaryUnmarshalerType) {
+		return nil
+	}
+
+	switch tree := tree.(type) {
+	case map[string]any:
+		for key, value := range tree {
+			var valueType reflect.Type
+
+			switch t.Kind() {
+			case reflect.Struct:
+				field, ok := binaryFields(params, t).lookup(key, false)
+				if !ok {
+					continue
+				}
+
+				if field.excluded {
+					return NewExcludedKey(fullPath, 0, joinKeyPath(keyPath, key))
+				}
+
+				valueType = field.typ
+
+			case reflect.Map:
+				valueType = t.Elem()
+
+			default:
+				continue
+			}
+
+			if err := checkBinaryKeys(params, fullPath, value, valueType, joinKeyPath(keyPath, key)); err != nil {
+				return err
+			}
+		}
+
+	case []any:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return nil
+		}
+
+		for i, item := range tree {
+			if err := checkBinaryKeys(params, fullPath, item, t.Elem(), fmt.Sprintf("%s[%d]", keyPath, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
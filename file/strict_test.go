@@ -0,0 +1,110 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Strict(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname string `yaml:"hostname"`
+		Port     uint
+	}
+
+	type Config struct {
+		Database DatabaseConfig            `yaml:"database"`
+		Replicas []DatabaseConfig          `yaml:"replicas"`
+		Labels   map[string]DatabaseConfig `yaml:"labels"`
+		Token    string                    `yacl:",nofile"`
+	}
+
+	testCases := []struct {
+		name     string
+		filename string
+		content  string
+		expected error
+	}{
+		{
+			name:     "yaml-valid",
+			filename: "config.yaml",
+			content:  "database:\n  hostname: localhost\n  port: 5432\nlabels:\n  main:\n    hostname: main\n",
+		}, {
+			name:     "yaml-root",
+			filename: "config.yaml",
+			content:  "databse:\n  hostname: localhost\n",
+			expected: &UnknownKey{Line: 1, Key: "databse", Suggestion: "database"},
+		}, {
+			name:     "yaml-nested",
+			filename: "config.yaml",
+			content:  "database:\n  hostname: localhost\nreplicas:\n  - hostname: first\n  - hostnme: second\n",
+			expected: &UnknownKey{Line: 5, Key: "replicas[1].hostnme", Suggestion: "hostname"},
+		}, {
+			name:     "yaml-map",
+			filename: "config.yaml",
+			content:  "labels:\n  main:\n    timeout: 10\n",
+			expected: &UnknownKey{Line: 3, Key: "labels.main.timeout"},
+		}, {
+			name:     "yaml-excluded",
+			filename: "config.yaml",
+			content:  "database:\n  hostname: localhost\ntoken: secret\n",
+			expected: &ExcludedKey{Line: 3, Key: "token"},
+		}, {
+			name:     "json-valid",
+			filename: "config.json",
+			content:  `{"database": {"hostname": "localhost", "PORT": 5432}}`,
+		}, {
+			name:     "json-nested",
+			filename: "config.json",
+			content:  "{\n  \"database\": {\n    \"hostname\": \"localhost\",\n    \"prot\": 5432\n  }\n}",
+			expected: &UnknownKey{Line: 4, Key: "database.prot", Suggestion: "port"},
+		}, {
+			name:     "json-excluded",
+			filename: "config.json",
+			content:  "{\n  \"Token\": \"secret\"\n}",
+			expected: &ExcludedKey{Line: 2, Key: "Token"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			fullPath := filepath.Join(tempDir, tc.filename)
+			assert.NoError(t, os.WriteFile(fullPath, []byte(tc.content), 0644))
+
+			params := DefaultParams(tempDir)
+			parse := func() error {
+				var err error
+
+				if filepath.Ext(tc.filename) == YAMLExtension {
+					_, err = ParseYAML[Config](params)
+				} else {
+					_, err = ParseJSON[Config](params)
+				}
+
+				return err
+			}
+
+			// Unknown keys are ignored unless strict
+			assert.NoError(t, parse())
+
+			params.Strict = true
+			err := parse()
+
+			if tc.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				switch expected := tc.expected.(type) {
+				case *UnknownKey:
+					expected.Path = fullPath
+				case *ExcludedKey:
+					expected.Path = fullPath
+				}
+
+				assert.Equal(t, tc.expected, err)
+			}
+		})
+	}
+}
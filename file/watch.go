@@ -0,0 +1,94 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type fileState struct {
+	exists  bool
+	size    int64
+	modTime time.Time
+}
+
+// Watcher polls config files and files in dirs for changes.
+type Watcher struct {
+	params *Params
+	dirs   []string
+	states map[string]fileState
+}
+
+func NewWatcher(params *Params, dirs ...string) *Watcher {
+	watcher := &Watcher{params: params, dirs: dirs}
+	watcher.states = watcher.stat()
+
+	return watcher
+}
+
+// Changed reports whether any of the files changed since the previous call.
+func (s *Watcher) Changed() bool {
+	states := s.stat()
+	changed := len(states) != len(s.states)
+
+	for fullPath, state := range states {
+		if prevState, ok := s.states[fullPath]; !ok || prevState != state {
+			changed = true
+		}
+	}
+
+	s.states = states
+
+	return changed
+}
+
+func (s *Watcher) stat() map[string]fileState {
+	states := make(map[string]fileState)
+
+	for _, path := range s.params.Paths {
+		for _, filename := range Filenames(s.params) {
+			fullPath := filepath.Join(path, filename)
+
+			info, err := os.Stat(fullPath)
+			if err != nil {
+				states[fullPath] = fileState{}
+				continue
+			}
+
+			states[fullPath] = statFile(info)
+		}
+	}
+
+	for _, dir := range s.dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+
+			fullPath := filepath.Join(dir, entry.Name())
+
+			info, err := os.Stat(fullPath) // follows symlinks
+			if err != nil || info.IsDir() {
+				continue
+			}
+
+			states[fullPath] = statFile(info)
+		}
+	}
+
+	return states
+}
+
+func statFile(info os.FileInfo) fileState {
+	return fileState{
+		exists:  true,
+		size:    info.Size(),
+		modTime: info.ModTime(),
+	}
+}
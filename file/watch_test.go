@@ -0,0 +1,52 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher_Changed(t *testing.T) {
+	tempDir := t.TempDir()
+	tempFile := filepath.Join(tempDir, "config.yaml")
+
+	watcher := NewWatcher(DefaultParams(tempDir))
+	assert.False(t, watcher.Changed())
+
+	// Create
+	assert.NoError(t, os.WriteFile(tempFile, []byte("hostname: first"), 0644))
+	assert.True(t, watcher.Changed())
+	assert.False(t, watcher.Changed())
+
+	// Modify
+	assert.NoError(t, os.WriteFile(tempFile, []byte("hostname: second-value"), 0644))
+	assert.NoError(t, os.Chtimes(tempFile, time.Now(), time.Now().Add(time.Second)))
+	assert.True(t, watcher.Changed())
+	assert.False(t, watcher.Changed())
+
+	// Remove
+	assert.NoError(t, os.Remove(tempFile))
+	assert.True(t, watcher.Changed())
+	assert.False(t, watcher.Changed())
+}
+
+func TestWatcher_ChangedDir(t *testing.T) {
+	tempDir := t.TempDir()
+	secretsDir := t.TempDir()
+	secretFile := filepath.Join(secretsDir, "DATABASE_PASSWORD")
+
+	assert.NoError(t, os.WriteFile(secretFile, []byte("first"), 0644))
+
+	watcher := NewWatcher(DefaultParams(tempDir), secretsDir)
+	assert.False(t, watcher.Changed())
+
+	assert.NoError(t, os.WriteFile(secretFile, []byte("second-value"), 0644))
+	assert.True(t, watcher.Changed())
+	assert.False(t, watcher.Changed())
+
+	assert.NoError(t, os.WriteFile(filepath.Join(secretsDir, "API_KEY"), []byte("key"), 0644))
+	assert.True(t, watcher.Changed())
+}
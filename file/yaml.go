@@ -2,40 +2,120 @@ package file
 
 import (
 	"os"
-	"path/filepath"
+	"reflect"
 
 	"gopkg.in/yaml.v3"
 )
 
 func ParseYAML[T any](params *Params) (*T, error) {
 	var cfg T
-	filename := params.Filename + ".yaml"
 
-	for _, path := range params.Paths {
-		fullPath := filepath.Join(path, filename)
+	fullPath, err := Lookup(params, params.Filename+YAMLExtension)
+	if err != nil {
+		return nil, err
+	}
 
-		_, err := os.Stat(fullPath)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue // try next path
-			}
+	yamlFile, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, err // some other error occurred
-		}
+	var document yaml.Node
+
+	if err = yaml.Unmarshal(yamlFile, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 {
+		return &cfg, nil // empty file
+	}
 
-		yamlFile, err := os.ReadFile(fullPath)
-		if err != nil {
+	if params.Interpolate {
+		interpolator := newInterpolator(params.Environ)
+		interpolator.expandYAML(&document)
+
+		if err = interpolator.err(fullPath); err != nil {
 			return nil, err
 		}
+	}
 
-		if err = yaml.Unmarshal(yamlFile, &cfg); err != nil {
+	if params.Strict {
+		if err = checkYAMLKeys(params, fullPath, &document, reflect.TypeOf(cfg)); err != nil {
 			return nil, err
 		}
+	}
+
+	renameYAMLKeys(params, document.Content[0], reflect.TypeOf(cfg))
+
+	if err = document.Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+func renameYAMLKeys(params *Params, node *yaml.Node, t reflect.Type) {
+	t = indirect(t)
 
-		return &cfg, nil
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
 	}
 
-	err := NewNotFound(filename, params.Paths)
+	if reflect.PointerTo(t).Implements(yamlUnmarshalerType) {
+		return
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && node.Kind == yaml.MappingNode:
+		fields := yamlFields(params, t)
+		content := node.Content[:0]
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
 
-	return nil, err
+			switch field, ok := fields.lookup(key.Value, false); {
+			case key.ShortTag() == "!!merge":
+				renameYAMLMerge(params, value, t)
+
+			case ok && field.excluded:
+				continue
+
+			case ok:
+				key.Value = field.key
+				renameYAMLKeys(params, value, field.typ)
+
+			case fields.rest != nil:
+				renameYAMLKeys(params, value, indirect(fields.rest).Elem())
+			}
+
+			content = append(content, key, value)
+		}
+
+		node.Content = content
+
+	case t.Kind() == reflect.Map && node.Kind == yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			renameYAMLKeys(params, node.Content[i], t.Elem())
+		}
+
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && node.Kind == yaml.SequenceNode:
+		for _, item := range node.Content {
+			renameYAMLKeys(params, item, t.Elem())
+		}
+	}
+}
+
+func renameYAMLMerge(params *Params, node *yaml.Node, t reflect.Type) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		renameYAMLKeys(params, node, t)
+		return
+	}
+
+	for _, item := range node.Content {
+		renameYAMLKeys(params, item, t)
+	}
 }
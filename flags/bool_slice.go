@@ -7,8 +7,8 @@ import (
 	"strconv"
 )
 
-func bindBoolSliceFlag(flagName string, value reflect.Value) {
-	flag.Var(newBoolSliceValue(value), flagName, "")
+func bindBoolSliceFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newBoolSliceValue(value), flagName, usage)
 }
 
 type boolSliceValue struct {
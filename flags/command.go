@@ -0,0 +1,133 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/andrew528i/yacl/utils"
+)
+
+// commandFields returns top-level fields tagged with cmd, e.g. `cmd:"serve"`.
+func commandFields(typ reflect.Type) []reflect.StructField {
+	commands := make([]reflect.StructField, 0)
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if commandName(field) != "" && field.IsExported() && field.Type.Kind() == reflect.Struct {
+			commands = append(commands, field)
+		}
+	}
+
+	return commands
+}
+
+func commandName(field reflect.StructField) string {
+	return utils.TagName(field.Tag, "cmd")
+}
+
+// lookupCommand returns the command field by its name.
+func (s *flagSet) lookupCommand(name string) (reflect.StructField, bool) {
+	for _, command := range s.commands {
+		if commandName(command) == name {
+			return command, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// unknownCommand returns UnknownCommand with the closest command name.
+func (s *flagSet) unknownCommand(name string) error {
+	names := make([]string, 0, len(s.commands))
+
+	for _, command := range s.commands {
+		names = append(names, commandName(command))
+	}
+
+	return NewUnknownCommand(name, utils.Closest(name, names))
+}
+
+// newCommandFlagSet makes the set of flags of the subcommand.
+func newCommandFlagSet(parent *flagSet, command reflect.StructField) *flagSet {
+	set := newFlagSet(parent.params)
+	set.command = &command
+	set.parent = parent
+
+	return set
+}
+
+func (s *flagSet) owns(fields []reflect.StructField) bool {
+	if s.command != nil {
+		return fields[0].Name == s.command.Name
+	}
+
+	for _, command := range s.commands {
+		if fields[0].Name == command.Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *flagSet) nameFields(fields []reflect.StructField) []reflect.StructField {
+	if s.command != nil {
+		return fields[1:]
+	}
+
+	return fields
+}
+
+// share registers flags of the parent which the subcommand doesn't redefine.
+func (s *flagSet) share() {
+	for _, flagName := range s.parent.flags {
+		names := []string{flagName, s.parent.negations[flagName]}
+
+		if short, ok := s.parent.shortNames[flagName]; ok {
+			names = append(names, short)
+
+			if _, ok = s.shorts[short]; !ok {
+				s.shorts[short] = flagName
+			}
+		}
+
+		for _, name := range names {
+			if name == "" || s.Lookup(name) != nil {
+				continue
+			}
+
+			f := s.parent.Lookup(name)
+			s.Var(f.Value, name, f.Usage)
+		}
+	}
+}
+
+func (s *flagSet) parseCommand(args []string) error {
+	s.share()
+
+	if err := s.parse(args); err != nil {
+		return err
+	}
+
+	for name := range s.visited {
+		if _, ok := s.fieldPaths[name]; !ok {
+			s.parent.visited[name] = true
+		}
+	}
+
+	return s.parent.checkConflicts()
+}
+
+func (s *flagSet) printCommands() {
+	for _, command := range s.commands {
+		line := "  " + commandName(command)
+
+		if description := command.Tag.Get("description"); description != "" {
+			line += "\n    \t" + strings.ReplaceAll(description, "\n", "\n    \t")
+		}
+
+		_, _ = fmt.Fprintln(s.Output(), line)
+	}
+}
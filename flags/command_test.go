@@ -0,0 +1,119 @@
+package flags
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/andrew528i/yacl/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Command(t *testing.T) {
+	type ServeConfig struct {
+		Port    uint `short:"p"`
+		Verbose bool
+	}
+
+	type MigrateConfig struct {
+		Steps int
+	}
+
+	type Config struct {
+		Verbose bool          `short:"v"`
+		Serve   ServeConfig   `cmd:"serve"`
+		Migrate MigrateConfig `cmd:"migrate"`
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *Result[Config]
+		err      error
+	}{
+		{
+			name: "no-command",
+			args: []string{"-v"},
+			expected: &Result[Config]{
+				Config:   &Config{Verbose: true},
+				Explicit: [][]string{{"Verbose"}},
+			},
+		}, {
+			name: "command",
+			args: []string{"-v", "serve", "-p", "8080", "--verbose"},
+			expected: &Result[Config]{
+				Config:   &Config{Verbose: true, Serve: ServeConfig{Port: 8080, Verbose: true}},
+				Explicit: [][]string{{"Serve", "Verbose"}, {"Verbose"}},
+				Command:  "serve",
+			},
+		}, {
+			name: "global-after-command",
+			args: []string{"migrate", "--steps", "3", "-v"},
+			expected: &Result[Config]{
+				Config:   &Config{Verbose: true, Migrate: MigrateConfig{Steps: 3}},
+				Explicit: [][]string{{"Verbose"}},
+				Command:  "migrate",
+			},
+		}, {
+			name: "unknown-command",
+			args: []string{"serv"},
+			err:  NewUnknownCommand("serv", "serve"),
+		}, {
+			name: "conflict-after-command",
+			args: []string{"-v", "migrate", "--no-verbose"},
+			err:  NewConflictingFlags("verbose", "no-verbose"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Args = tc.args
+
+			result, err := ParseResult[Config](params)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestFlagSet_CommandUsage(t *testing.T) {
+	type ServeConfig struct {
+		Port uint `description:"port to listen on"`
+	}
+
+	type Config struct {
+		Verbose bool
+		Serve   ServeConfig `cmd:"serve" description:"run the server"`
+	}
+
+	var cfg Config
+	var output bytes.Buffer
+
+	set := newFlagSet(DefaultParams())
+	set.commands = commandFields(reflect.TypeOf(cfg))
+	set.SetOutput(&output)
+	assert.NoError(t, utils.WalkStructFields(&cfg, set.bind))
+	set.bindNegations()
+
+	set.Usage()
+
+	assert.Equal(t, "Usage:\n      --[no-]verbose\n\nCommands:\n  serve\n    \trun the server\n", output.String())
+
+	output.Reset()
+
+	commandSet := newCommandFlagSet(set, set.commands[0])
+	commandSet.SetOutput(&output)
+	assert.NoError(t, utils.WalkStructFields(&cfg, commandSet.bind))
+	commandSet.bindNegations()
+
+	commandSet.Usage()
+
+	expected := "Usage of serve:\n" +
+		"      --port uint\n" +
+		"    \tport to listen on\n" +
+		"\nGlobal flags:\n" +
+		"      --[no-]verbose\n"
+
+	assert.Equal(t, expected, output.String())
+}
@@ -0,0 +1,137 @@
+package flags
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/andrew528i/yacl/utils"
+)
+
+// CompletionFlag is the hidden flag printing the completion script.
+const CompletionFlag = "completion"
+
+// completionFlag describes a flag for completion scripts.
+type completionFlag struct {
+	name        string
+	short       string
+	negatedName string
+	description string
+
+	// takesValue is set for flags which aren't bool ones
+	takesValue bool
+	repeatable bool
+
+	// values are completed from oneof tag, e.g. `oneof:"debug info warn"`
+	values []string
+
+	// path is set for fields with path option, e.g. `yacl:",path"`
+	path bool
+}
+
+type completionCommand struct {
+	name        string
+	description string
+	flags       []completionFlag
+}
+
+// completionSpec describes flags and subcommands of the program.
+type completionSpec struct {
+	program  string
+	flags    []completionFlag
+	commands []completionCommand
+}
+
+// Completion returns the completion script of the shell: bash, zsh or fish.
+func Completion[T any](params *Params, shell, program string) (string, error) {
+	var cfg T
+
+	set := newFlagSet(params)
+	set.commands = commandFields(reflect.TypeOf(cfg))
+
+	if err := utils.WalkStructFields[T](&cfg, set.bind); err != nil {
+		return "", err
+	}
+
+	set.bindNegations()
+
+	spec := &completionSpec{
+		program: program,
+		flags:   set.completionFlags(),
+	}
+
+	for _, command := range set.commands {
+		commandSet := newCommandFlagSet(set, command)
+
+		if err := utils.WalkStructFields[T](&cfg, commandSet.bind); err != nil {
+			return "", err
+		}
+
+		commandSet.bindNegations()
+
+		spec.commands = append(spec.commands, completionCommand{
+			name:        commandName(command),
+			description: command.Tag.Get("description"),
+			flags:       commandSet.completionFlags(),
+		})
+	}
+
+	switch shell {
+	case "bash":
+		return bashCompletion(spec), nil
+
+	case "zsh":
+		return zshCompletion(spec), nil
+
+	case "fish":
+		return fishCompletion(spec), nil
+
+	default:
+		return "", NewUnsupportedShell(shell)
+	}
+}
+
+// completionFlags describes flags of the set in the order of fields.
+func (s *flagSet) completionFlags() []completionFlag {
+	flags := make([]completionFlag, 0, len(s.flags))
+
+	for _, flagName := range s.flags {
+		f := s.Lookup(flagName)
+		field := s.structFields[flagName]
+
+		flags = append(flags, completionFlag{
+			name:        flagName,
+			short:       s.shortNames[flagName],
+			negatedName: s.negations[flagName],
+			description: f.Usage,
+			takesValue:  !isBoolFlag(f),
+			repeatable:  field.Type.Kind() == reflect.Slice || utils.HasTagOption(field.Tag, "flag", "count"),
+			values:      strings.Fields(field.Tag.Get("oneof")),
+			path:        utils.HasTagOption(field.Tag, "yacl", "path"),
+		})
+	}
+
+	return flags
+}
+
+// printCompletion prints the completion script and exits like -h does.
+func printCompletion[T any](params *Params, shell string) {
+	script, err := Completion[T](params, shell, filepath.Base(os.Args[0]))
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	_, _ = fmt.Fprint(os.Stdout, script)
+	os.Exit(0)
+}
+
+var identifierRegexp = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// functionName returns the name of the shell function completing program.
+func functionName(program string) string {
+	return "_" + identifierRegexp.ReplaceAllString(program, "_")
+}
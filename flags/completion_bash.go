@@ -0,0 +1,105 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+)
+
+func bashCompletion(spec *completionSpec) string {
+	var b strings.Builder
+
+	function := functionName(spec.program)
+
+	_, _ = fmt.Fprintf(&b, "# bash completion for %s\n\n", spec.program)
+	_, _ = fmt.Fprintf(&b, "%s() {\n", function)
+	b.WriteString("    local cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	b.WriteString("    local prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n")
+
+	if len(spec.commands) == 0 {
+		b.WriteString("\n")
+		writeBashFlags(&b, "    ", spec.flags, nil)
+	} else {
+		names := make([]string, 0, len(spec.commands))
+		for _, command := range spec.commands {
+			names = append(names, command.name)
+		}
+
+		b.WriteString("    local command=\"\" i\n\n")
+		b.WriteString("    for ((i = 1; i < COMP_CWORD; i++)); do\n")
+		b.WriteString("        case \"${COMP_WORDS[i]}\" in\n")
+		_, _ = fmt.Fprintf(&b, "            %s)\n", strings.Join(names, "|"))
+		b.WriteString("                command=\"${COMP_WORDS[i]}\"\n")
+		b.WriteString("                break\n")
+		b.WriteString("                ;;\n")
+		b.WriteString("        esac\n")
+		b.WriteString("    done\n\n")
+		b.WriteString("    case \"$command\" in\n")
+
+		for _, command := range spec.commands {
+			_, _ = fmt.Fprintf(&b, "        %s)\n", command.name)
+			writeBashFlags(&b, "            ", append(command.flags, spec.flags...), nil)
+			b.WriteString("            ;;\n")
+		}
+
+		b.WriteString("        *)\n")
+		writeBashFlags(&b, "            ", spec.flags, names)
+		b.WriteString("            ;;\n")
+		b.WriteString("    esac\n")
+	}
+
+	b.WriteString("}\n\n")
+	_, _ = fmt.Fprintf(&b, "complete -o default -F %s %s\n", function, spec.program)
+
+	return b.String()
+}
+
+func writeBashFlags(b *strings.Builder, indent string, flags []completionFlag, words []string) {
+	valueCases := make([]string, 0)
+
+	for _, f := range flags {
+		if !f.takesValue {
+			continue
+		}
+
+		pattern := "--" + f.name
+		if f.short != "" {
+			pattern = "-" + f.short + "|" + pattern
+		}
+
+		valueCase := fmt.Sprintf("%s    %s)\n", indent, pattern)
+
+		switch {
+		case len(f.values) > 0:
+			valueCase += fmt.Sprintf("%s        COMPREPLY=($(compgen -W \"%s\" -- \"$cur\"))\n", indent, strings.Join(f.values, " "))
+
+		case f.path:
+			valueCase += fmt.Sprintf("%s        COMPREPLY=($(compgen -f -- \"$cur\"))\n", indent)
+		}
+
+		valueCases = append(valueCases, valueCase+fmt.Sprintf("%s        return\n%s        ;;\n", indent, indent))
+	}
+
+	if len(valueCases) > 0 {
+		_, _ = fmt.Fprintf(b, "%scase \"$prev\" in\n", indent)
+
+		for _, valueCase := range valueCases {
+			b.WriteString(valueCase)
+		}
+
+		_, _ = fmt.Fprintf(b, "%sesac\n\n", indent)
+	}
+
+	for _, f := range flags {
+		if f.short != "" {
+			words = append(words, "-"+f.short)
+		}
+
+		words = append(words, "--"+f.name)
+
+		if f.negatedName != "" {
+			words = append(words, "--"+f.negatedName)
+		}
+	}
+
+	_, _ = fmt.Fprintf(b, "%sCOMPREPLY=($(compgen -W \"%s\" -- \"$cur\"))\n", indent, strings.Join(words, " "))
+}
@@ -0,0 +1,75 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+)
+
+func fishCompletion(spec *completionSpec) string {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "# fish completion for %s\n\n", spec.program)
+
+	for _, command := range spec.commands {
+		line := fmt.Sprintf("complete -c %s -n __fish_use_subcommand -f -a %s", spec.program, fishQuote(command.name))
+		if command.description != "" {
+			line += " -d " + fishQuote(command.description)
+		}
+
+		b.WriteString(line + "\n")
+	}
+
+	writeFishFlags(&b, spec.program, "", spec.flags)
+
+	for _, command := range spec.commands {
+		writeFishFlags(&b, spec.program, "__fish_seen_subcommand_from "+command.name, command.flags)
+	}
+
+	return b.String()
+}
+
+func writeFishFlags(b *strings.Builder, program, condition string, flags []completionFlag) {
+	prefix := "complete -c " + program
+	if condition != "" {
+		prefix += " -n " + fishQuote(condition)
+	}
+
+	for _, f := range flags {
+		line := prefix + " -l " + fishQuote(f.name)
+		if f.short != "" {
+			line += " -s " + fishQuote(f.short)
+		}
+
+		if f.takesValue {
+			switch {
+			case len(f.values) > 0:
+				line += " -x -a " + fishQuote(strings.Join(f.values, " "))
+
+			case f.path:
+				line += " -r -F"
+
+			default:
+				line += " -x"
+			}
+		}
+
+		if f.description != "" {
+			line += " -d " + fishQuote(f.description)
+		}
+
+		b.WriteString(line + "\n")
+
+		if f.negatedName != "" {
+			line = prefix + " -l " + fishQuote(f.negatedName)
+			if f.description != "" {
+				line += " -d " + fishQuote(f.description)
+			}
+
+			b.WriteString(line + "\n")
+		}
+	}
+}
+
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`, "\n", " ").Replace(s) + "'"
+}
@@ -0,0 +1,97 @@
+package flags
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type completionConfig struct {
+	Verbose  bool   `short:"v" description:"print more"`
+	LogLevel string `oneof:"debug info warn" description:"level of logs"`
+	CertPath string `yacl:"cert,path"`
+
+	Serve struct {
+		Port uint `short:"p" description:"port to listen"`
+	} `cmd:"serve" description:"start the server"`
+}
+
+func TestCompletion(t *testing.T) {
+	testCases := []struct {
+		shell    string
+		expected []string
+	}{
+		{
+			shell: "bash",
+			expected: []string{
+				"_svc() {",
+				`COMPREPLY=($(compgen -W "debug info warn" -- "$cur"))`,
+				"--cert)",
+				`COMPREPLY=($(compgen -f -- "$cur"))`,
+				"serve)",
+				`-v --verbose --no-verbose --log-level --cert`,
+				"-p|--port)",
+				"complete -o default -F _svc svc",
+			},
+		},
+		{
+			shell: "zsh",
+			expected: []string{
+				"#compdef svc",
+				"'(-v --verbose --no-verbose)-v[print more]'",
+				"'(--log-level)--log-level=[level of logs]:log-level:(debug info warn)'",
+				"'(--cert)--cert=:cert:_files'",
+				"'serve:start the server'",
+				"'(-p --port)-p[port to listen]:port: '",
+				"compdef _svc svc",
+			},
+		},
+		{
+			shell: "fish",
+			expected: []string{
+				"complete -c svc -n __fish_use_subcommand -f -a 'serve' -d 'start the server'",
+				"complete -c svc -l 'verbose' -s 'v' -d 'print more'",
+				"complete -c svc -l 'no-verbose' -d 'print more'",
+				"complete -c svc -l 'log-level' -x -a 'debug info warn' -d 'level of logs'",
+				"complete -c svc -l 'cert' -r -F",
+				"complete -c svc -n '__fish_seen_subcommand_from serve' -l 'port' -s 'p' -x -d 'port to listen'",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.shell, func(t *testing.T) {
+			script, err := Completion[completionConfig](DefaultParams(), tc.shell, "svc")
+			assert.NoError(t, err)
+
+			for _, expected := range tc.expected {
+				assert.Contains(t, script, expected)
+			}
+		})
+	}
+}
+
+func TestCompletion_UnsupportedShell(t *testing.T) {
+	_, err := Completion[completionConfig](DefaultParams(), "powershell", "svc")
+	assert.Equal(t, NewUnsupportedShell("powershell"), err)
+}
+
+func TestParse_CompletionFlagHidden(t *testing.T) {
+	params := DefaultParams()
+	params.Completion = true
+	params.Args = []string{"-v"}
+
+	cfg, err := Parse[completionConfig](params)
+	assert.NoError(t, err)
+	assert.True(t, cfg.Verbose)
+
+	var output bytes.Buffer
+
+	flag.CommandLine.SetOutput(&output)
+	flag.CommandLine.Usage()
+
+	assert.Contains(t, output.String(), "--log-level")
+	assert.NotContains(t, output.String(), CompletionFlag)
+}
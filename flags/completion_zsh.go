@@ -0,0 +1,122 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+)
+
+func zshCompletion(spec *completionSpec) string {
+	var b strings.Builder
+
+	function := functionName(spec.program)
+
+	_, _ = fmt.Fprintf(&b, "#compdef %s\n\n", spec.program)
+	_, _ = fmt.Fprintf(&b, "%s() {\n", function)
+
+	if len(spec.commands) == 0 {
+		writeZshArguments(&b, "    ", spec.flags, "'*: :_default'")
+	} else {
+		b.WriteString("    local context state state_descr line\n")
+		b.WriteString("    typeset -A opt_args\n\n")
+		writeZshArguments(&b, "    ", spec.flags, "'1: :->command'", "'*:: :->args'")
+		b.WriteString("\n    case $state in\n")
+		b.WriteString("        command)\n")
+		b.WriteString("            local -a commands\n")
+		b.WriteString("            commands=(\n")
+
+		for _, command := range spec.commands {
+			description := strings.ReplaceAll(command.description, ":", "\\:")
+			_, _ = fmt.Fprintf(&b, "                %s\n", zshQuote(command.name+":"+description))
+		}
+
+		b.WriteString("            )\n")
+		b.WriteString("            _describe command commands\n")
+		b.WriteString("            ;;\n")
+		b.WriteString("        args)\n")
+		b.WriteString("            case $line[1] in\n")
+
+		for _, command := range spec.commands {
+			_, _ = fmt.Fprintf(&b, "                %s)\n", command.name)
+			writeZshArguments(&b, "                    ", append(command.flags, spec.flags...), "'*: :_default'")
+			b.WriteString("                    ;;\n")
+		}
+
+		b.WriteString("            esac\n")
+		b.WriteString("            ;;\n")
+		b.WriteString("    esac\n")
+	}
+
+	b.WriteString("}\n\n")
+	_, _ = fmt.Fprintf(&b, "compdef %s %s\n", function, spec.program)
+
+	return b.String()
+}
+
+func writeZshArguments(b *strings.Builder, indent string, flags []completionFlag, positionals ...string) {
+	specs := make([]string, 0)
+
+	for _, f := range flags {
+		names := []string{"--" + f.name}
+		if f.short != "" {
+			names = append([]string{"-" + f.short}, names...)
+		}
+
+		if f.negatedName != "" {
+			names = append(names, "--"+f.negatedName)
+		}
+
+		exclusion := "(" + strings.Join(names, " ") + ")"
+		if f.repeatable {
+			exclusion = "*"
+		}
+
+		description := ""
+		if f.description != "" {
+			description = "[" + zshEscape(f.description) + "]"
+		}
+
+		action := ""
+		if f.takesValue {
+			switch {
+			case len(f.values) > 0:
+				action = ":" + f.name + ":(" + strings.Join(f.values, " ") + ")"
+
+			case f.path:
+				action = ":" + f.name + ":_files"
+
+			default:
+				action = ":" + f.name + ": "
+			}
+		}
+
+		for _, name := range names {
+			// Long flags take the value either after = or in the next word
+			if f.takesValue && strings.HasPrefix(name, "--") {
+				name += "="
+			}
+
+			specs = append(specs, zshQuote(exclusion+name+description+action))
+		}
+	}
+
+	specs = append(specs, positionals...)
+
+	_, _ = fmt.Fprintf(b, "%s_arguments -s -C \\\n", indent)
+
+	for i, spec := range specs {
+		if i < len(specs)-1 {
+			_, _ = fmt.Fprintf(b, "%s    %s \\\n", indent, spec)
+		} else {
+			_, _ = fmt.Fprintf(b, "%s    %s\n", indent, spec)
+		}
+	}
+}
+
+// zshEscape escapes brackets closing descriptions of flags.
+func zshEscape(s string) string {
+	return strings.NewReplacer("[", "\\[", "]", "\\]", "\n", " ").Replace(s)
+}
+
+func zshQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,49 @@
+package flags
+
+import (
+	"flag"
+	"reflect"
+	"strconv"
+)
+
+func bindCountFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newCountValue(value), flagName, usage)
+}
+
+// countValue counts occurrences of the flag, e.g. -vvv sets 3.
+type countValue struct {
+	value reflect.Value
+}
+
+func newCountValue(v reflect.Value) *countValue {
+	return &countValue{v}
+}
+
+func (s *countValue) String() string {
+	if !s.value.IsValid() {
+		return "0"
+	}
+
+	return strconv.FormatInt(s.value.Int(), 10)
+}
+
+func (s *countValue) Set(value string) error {
+	if value == "true" {
+		s.value.SetInt(s.value.Int() + 1)
+
+		return nil
+	}
+
+	i, err := strconv.ParseInt(value, 10, s.value.Type().Bits())
+	if err != nil {
+		return err
+	}
+
+	s.value.SetInt(i)
+
+	return nil
+}
+
+func (s *countValue) IsBoolFlag() bool {
+	return true
+}
@@ -0,0 +1,74 @@
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Count(t *testing.T) {
+	type Config struct {
+		Verbose int  `short:"v" flag:",count"`
+		Force   bool `short:"f"`
+		Retries int8 `flag:"retry,count"`
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected Config
+	}{
+		{
+			name:     "repeated",
+			args:     []string{"-v", "-v", "--verbose"},
+			expected: Config{Verbose: 3},
+		}, {
+			name:     "combined",
+			args:     []string{"-vfvv"},
+			expected: Config{Verbose: 3, Force: true},
+		}, {
+			name:     "explicit",
+			args:     []string{"-v", "--verbose=5", "--retry", "--retry"},
+			expected: Config{Verbose: 5, Retries: 2},
+		}, {
+			name:     "set-one",
+			args:     []string{"-vv", "--verbose=1"},
+			expected: Config{Verbose: 1},
+		}, {
+			name:     "reset",
+			args:     []string{"-vv", "--verbose=0"},
+			expected: Config{},
+		}, {
+			name:     "empty",
+			args:     []string{},
+			expected: Config{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Args = tc.args
+
+			cfg, err := Parse[Config](params)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, *cfg)
+		})
+	}
+}
+
+func TestCountValue_Set(t *testing.T) {
+	var verbose int
+	value := newCountValue(reflect.ValueOf(&verbose).Elem())
+
+	assert.NoError(t, value.Set("true"))
+	assert.NoError(t, value.Set("true"))
+	assert.Equal(t, 2, verbose)
+
+	assert.NoError(t, value.Set("0"))
+	assert.Equal(t, 0, verbose)
+
+	assert.Error(t, value.Set("false"))
+	assert.Error(t, value.Set("0x10"))
+}
@@ -0,0 +1,121 @@
+package flags
+
+import "fmt"
+
+type ConflictingFlags struct {
+	Name        string
+	NegatedName string
+}
+
+func NewConflictingFlags(name, negatedName string) *ConflictingFlags {
+	return &ConflictingFlags{
+		Name:        name,
+		NegatedName: negatedName,
+	}
+}
+
+func (s ConflictingFlags) Error() string {
+	return fmt.Sprintf("both --%v and --%v are set, only one of them is allowed", s.Name, s.NegatedName)
+}
+
+type UnknownCommand struct {
+	Name       string
+	Suggestion string
+}
+
+func NewUnknownCommand(name, suggestion string) *UnknownCommand {
+	return &UnknownCommand{
+		Name:       name,
+		Suggestion: suggestion,
+	}
+}
+
+func (s UnknownCommand) Error() string {
+	if s.Suggestion == "" {
+		return fmt.Sprintf("unknown command %v", s.Name)
+	}
+
+	return fmt.Sprintf("unknown command %v, did you mean %v?", s.Name, s.Suggestion)
+}
+
+type MissingArgument struct {
+	Name string
+}
+
+func NewMissingArgument(name string) *MissingArgument {
+	return &MissingArgument{
+		Name: name,
+	}
+}
+
+func (s MissingArgument) Error() string {
+	return fmt.Sprintf("missing argument <%v>", s.Name)
+}
+
+type UnexpectedArgument struct {
+	Value string
+}
+
+func NewUnexpectedArgument(value string) *UnexpectedArgument {
+	return &UnexpectedArgument{
+		Value: value,
+	}
+}
+
+func (s UnexpectedArgument) Error() string {
+	return fmt.Sprintf("unexpected argument %v", s.Value)
+}
+
+type InvalidArgument struct {
+	Name  string
+	Value string
+	Err   error
+}
+
+func NewInvalidArgument(name, value string, err error) *InvalidArgument {
+	return &InvalidArgument{
+		Name:  name,
+		Value: value,
+		Err:   err,
+	}
+}
+
+func (s InvalidArgument) Error() string {
+	return fmt.Sprintf("invalid argument <%v> %v: %v", s.Name, s.Value, s.Err)
+}
+
+func (s InvalidArgument) Unwrap() error {
+	return s.Err
+}
+
+type UnsupportedShell struct {
+	Shell string
+}
+
+func NewUnsupportedShell(shell string) *UnsupportedShell {
+	return &UnsupportedShell{
+		Shell: shell,
+	}
+}
+
+func (s UnsupportedShell) Error() string {
+	return fmt.Sprintf("unsupported shell %v, use bash, zsh or fish", s.Shell)
+}
+
+type InvalidTag struct {
+	Field  string
+	Tag    string
+	Reason string
+}
+
+func NewInvalidTag(field, tag, reason string) *InvalidTag {
+	return &InvalidTag{
+		Field:  field,
+		Tag:    tag,
+		Reason: reason,
+	}
+}
+
+func (s InvalidTag) Error() string {
+	return fmt.Sprintf("invalid %v tag of field %v: %v", s.Tag, s.Field, s.Reason)
+}
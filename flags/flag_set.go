@@ -0,0 +1,364 @@
+package flags
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/andrew528i/yacl/utils"
+)
+
+// flagSet binds config fields to flags, accepting GNU-style arguments.
+type flagSet struct {
+	*flag.FlagSet
+	params *Params
+
+	// flags holds names of flags in the order of fields
+	flags []string
+
+	// shorts maps short aliases to names of flags and back
+	shorts     map[string]string
+	shortNames map[string]string
+
+	// negations maps names of bool flags to names of flags negating them
+	negations map[string]string
+	bools     []boolFlag
+
+	// fieldPaths maps names of all the flags to paths of their fields
+	fieldPaths map[string][]string
+
+	// structFields maps names of flags to their fields
+	structFields map[string]reflect.StructField
+
+	// visited holds names of flags set by the arguments
+	visited map[string]bool
+
+	// commands holds top-level fields tagged with cmd
+	commands []reflect.StructField
+
+	// command is the subcommand field the set is made for
+	command *reflect.StructField
+	parent  *flagSet
+
+	// positionals are fields bound to positional arguments
+	positionals []*positional
+	rest        *positional
+}
+
+// boolFlag is a bool flag waiting for its negation to be bound.
+type boolFlag struct {
+	flagName    string
+	negatedName string
+	value       reflect.Value
+}
+
+func newFlagSet(params *Params) *flagSet {
+	set := &flagSet{
+		FlagSet:    flag.NewFlagSet("", flag.ExitOnError),
+		params:     params,
+		shorts:     make(map[string]string),
+		shortNames: make(map[string]string),
+		negations:  make(map[string]string),
+		fieldPaths: make(map[string][]string),
+		visited:    make(map[string]bool),
+
+		structFields: make(map[string]reflect.StructField),
+	}
+
+	set.Usage = set.printUsage
+
+	return set
+}
+
+// bind binds the field to the flag named after it.
+func (s *flagSet) bind(fields []reflect.StructField, value reflect.Value) error {
+	if !utils.FromSource(fields, utils.SourceFlag) || !s.owns(fields) {
+		return nil
+	}
+
+	field := fields[len(fields)-1]
+	if isPositional(field) {
+		return s.bindPositional(fields, value)
+	}
+
+	flagName := fieldFlagName(s.params, s.nameFields(fields))
+	usage := field.Tag.Get("description")
+
+	switch value.Kind() {
+	case reflect.String:
+		bindStringFlag(s.FlagSet, flagName, usage, value)
+
+	case reflect.Bool:
+		bindBoolFlag(s.FlagSet, flagName, usage, value)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bindUintFlag(s.FlagSet, flagName, usage, value)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if utils.HasTagOption(field.Tag, "flag", "count") {
+			bindCountFlag(s.FlagSet, flagName, usage, value)
+		} else {
+			bindIntFlag(s.FlagSet, flagName, usage, value)
+		}
+
+	case reflect.Float64:
+		bindFloat64Flag(s.FlagSet, flagName, usage, value)
+
+	case reflect.Slice:
+		elemKind := value.Type().Elem().Kind()
+		switch elemKind {
+		case reflect.String:
+			bindStringSliceFlag(s.FlagSet, flagName, usage, value)
+
+		case reflect.Bool:
+			bindBoolSliceFlag(s.FlagSet, flagName, usage, value)
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			bindUintSliceFlag(s.FlagSet, flagName, usage, value)
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			bindIntSliceFlag(s.FlagSet, flagName, usage, value)
+
+		case reflect.Float64:
+			bindFloat64SliceFlag(s.FlagSet, flagName, usage, value)
+
+		default:
+			panic(fmt.Sprintf("slice type not supported: `%s`", value.Type().Name()))
+		}
+
+	default:
+		panic(fmt.Sprintf("type not supported: `%s`", value.Type().Name()))
+	}
+
+	s.flags = append(s.flags, flagName)
+	s.fieldPaths[flagName] = utils.FieldPath(fields)
+	s.structFields[flagName] = field
+
+	if value.Kind() == reflect.Bool {
+		negatedName := fieldFlagName(s.params, s.nameFields(fields), "no")
+		s.bools = append(s.bools, boolFlag{flagName, negatedName, value})
+	}
+
+	if short := field.Tag.Get("short"); short != "" {
+		return s.bindShort(field, short, flagName)
+	}
+
+	return nil
+}
+
+// bindShort registers short as another name of the flag sharing its value.
+func (s *flagSet) bindShort(field reflect.StructField, short, flagName string) error {
+	if utf8.RuneCountInString(short) != 1 {
+		return NewInvalidTag(field.Name, "short", fmt.Sprintf("%v must be a single character", short))
+	}
+
+	if name, ok := s.shorts[short]; ok {
+		return NewInvalidTag(field.Name, "short", fmt.Sprintf("%v is already used by --%v", short, name))
+	}
+
+	if s.Lookup(short) != nil {
+		return NewInvalidTag(field.Name, "short", fmt.Sprintf("%v is already used by a flag", short))
+	}
+
+	f := s.Lookup(flagName)
+	s.Var(f.Value, short, f.Usage)
+
+	s.shorts[short] = flagName
+	s.shortNames[flagName] = short
+	s.fieldPaths[short] = s.fieldPaths[flagName]
+
+	return nil
+}
+
+// bindNegations binds flags setting bool fields to false unless the name is taken.
+func (s *flagSet) bindNegations() {
+	for _, b := range s.bools {
+		if s.Lookup(b.negatedName) != nil {
+			continue
+		}
+
+		bindNegatedBoolFlag(s.FlagSet, b.negatedName, s.Lookup(b.flagName).Usage, b.value)
+
+		s.negations[b.flagName] = b.negatedName
+		s.fieldPaths[b.negatedName] = s.fieldPaths[b.flagName]
+	}
+}
+
+func (s *flagSet) parse(args []string) error {
+	if err := s.Parse(s.expandArgs(args)); err != nil {
+		return err
+	}
+
+	s.Visit(func(f *flag.Flag) {
+		s.visited[f.Name] = true
+	})
+
+	return s.checkConflicts()
+}
+
+// checkConflicts fails if both a bool flag and its negation are set.
+func (s *flagSet) checkConflicts() error {
+	for _, flagName := range s.flags {
+		negatedName, ok := s.negations[flagName]
+		if ok && s.visited[negatedName] && s.isVisited(flagName) {
+			return NewConflictingFlags(flagName, negatedName)
+		}
+	}
+
+	return nil
+}
+
+func (s *flagSet) isVisited(flagName string) bool {
+	short, ok := s.shortNames[flagName]
+
+	return s.visited[flagName] || ok && s.visited[short]
+}
+
+// explicit returns paths of bool fields set by the arguments.
+func (s *flagSet) explicit() [][]string {
+	fieldPaths := make([][]string, 0)
+
+	for _, flagName := range s.flags {
+		if s.structFields[flagName].Type.Kind() != reflect.Bool {
+			continue
+		}
+
+		if s.isVisited(flagName) || s.visited[s.negations[flagName]] {
+			fieldPaths = append(fieldPaths, s.fieldPaths[flagName])
+		}
+	}
+
+	return fieldPaths
+}
+
+// expandArgs splits combined short flags, e.g. -vp 8080 into -v -p 8080.
+func (s *flagSet) expandArgs(args []string) []string {
+	expanded := make([]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || arg == "-" || !strings.HasPrefix(arg, "-") {
+			return append(expanded, args[i:]...)
+		}
+
+		expanded = append(expanded, s.expandArg(arg)...)
+
+		// Keep the value of the last flag as is, even if it starts with a dash
+		if s.takesValue(expanded[len(expanded)-1]) && i+1 < len(args) {
+			i++
+			expanded = append(expanded, args[i])
+		}
+	}
+
+	return expanded
+}
+
+func (s *flagSet) expandArg(arg string) []string {
+	name := strings.TrimPrefix(arg, "-")
+
+	if strings.HasPrefix(name, "-") || strings.Contains(name, "=") ||
+		s.Lookup(name) != nil || utf8.RuneCountInString(name) == 1 {
+		return []string{arg}
+	}
+
+	expanded := make([]string, 0, len(name))
+
+	for i, r := range name {
+		short := string(r)
+
+		f := s.Lookup(short)
+		if _, ok := s.shorts[short]; !ok || f == nil {
+			// Let the flag package report the unknown flag
+			return []string{arg}
+		}
+
+		if isBoolFlag(f) {
+			expanded = append(expanded, "-"+short)
+			continue
+		}
+
+		if rest := name[i+len(short):]; rest != "" {
+			return append(expanded, "-"+short+"="+rest)
+		}
+
+		expanded = append(expanded, "-"+short)
+	}
+
+	return expanded
+}
+
+func (s *flagSet) takesValue(arg string) bool {
+	name := strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+	if strings.Contains(name, "=") {
+		return false
+	}
+
+	f := s.Lookup(name)
+
+	return f != nil && !isBoolFlag(f)
+}
+
+// printUsage prints flags in GNU style, e.g. -p, --database-port uint.
+func (s *flagSet) printUsage() {
+	header := "Usage:"
+	if s.command != nil {
+		header = fmt.Sprintf("Usage of %s:", commandName(*s.command))
+	}
+
+	if s.hasPositionals() {
+		header += " " + s.synopsis()
+	}
+
+	_, _ = fmt.Fprintln(s.Output(), header)
+
+	s.printFlags()
+
+	if s.parent != nil && len(s.parent.flags) > 0 {
+		_, _ = fmt.Fprintln(s.Output(), "\nGlobal flags:")
+		s.parent.printFlags()
+	}
+
+	if len(s.commands) > 0 {
+		_, _ = fmt.Fprintln(s.Output(), "\nCommands:")
+		s.printCommands()
+	}
+}
+
+func (s *flagSet) printFlags() {
+	for _, flagName := range s.flags {
+		f := s.Lookup(flagName)
+		typeName, usage := flag.UnquoteUsage(f)
+
+		longName := "--" + flagName
+		if negatedName, ok := s.negations[flagName]; ok {
+			if negatedName == "no-"+flagName {
+				longName = "--[no-]" + flagName
+			} else {
+				longName += ", --" + negatedName
+			}
+		}
+
+		line := "      " + longName
+		if short, ok := s.shortNames[flagName]; ok {
+			line = "  -" + short + ", " + longName
+		}
+
+		if typeName != "" {
+			line += " " + typeName
+		}
+
+		if usage != "" {
+			line += "\n    \t" + strings.ReplaceAll(usage, "\n", "\n    \t")
+		}
+
+		_, _ = fmt.Fprintln(s.Output(), line)
+	}
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return ok && boolFlag.IsBoolFlag()
+}
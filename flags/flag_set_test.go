@@ -0,0 +1,183 @@
+package flags
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/andrew528i/yacl/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_GNU(t *testing.T) {
+	type Config struct {
+		Verbose bool   `short:"v"`
+		Force   bool   `short:"f"`
+		Port    uint   `short:"p"`
+		Name    string `short:"n"`
+		Tags    []string
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected Config
+	}{
+		{
+			name:     "double-dash",
+			args:     []string{"--verbose", "--port", "8080", "--name=app"},
+			expected: Config{Verbose: true, Port: 8080, Name: "app"},
+		}, {
+			name:     "short",
+			args:     []string{"-v", "-p", "8080", "-n=app"},
+			expected: Config{Verbose: true, Port: 8080, Name: "app"},
+		}, {
+			name:     "combined",
+			args:     []string{"-vfp", "8080"},
+			expected: Config{Verbose: true, Force: true, Port: 8080},
+		}, {
+			name:     "attached-value",
+			args:     []string{"-vp8080", "-napp"},
+			expected: Config{Verbose: true, Port: 8080, Name: "app"},
+		}, {
+			name:     "value-with-dash",
+			args:     []string{"-n", "-vf", "--tags", "-one", "--tags=two"},
+			expected: Config{Name: "-vf", Tags: []string{"-one", "two"}},
+		}, {
+			name:     "positional",
+			args:     []string{"-v", "--", "-fp"},
+			expected: Config{Verbose: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Args = tc.args
+
+			cfg, err := Parse[Config](params)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, *cfg)
+		})
+	}
+}
+
+func TestParse_InvalidShort(t *testing.T) {
+	type LongShort struct {
+		Verbose bool `short:"vv"`
+	}
+
+	type DuplicateShort struct {
+		Verbose bool `short:"v"`
+		Version bool `short:"v"`
+	}
+
+	params := DefaultParams()
+	params.Args = []string{}
+
+	_, err := Parse[LongShort](params)
+	assert.Equal(t, NewInvalidTag("Verbose", "short", "vv must be a single character"), err)
+
+	_, err = Parse[DuplicateShort](params)
+	assert.Equal(t, NewInvalidTag("Version", "short", "v is already used by --verbose"), err)
+}
+
+func TestFlagSet_Usage(t *testing.T) {
+	type DatabaseConfig struct {
+		Port uint `short:"p" description:"port of the database"`
+	}
+
+	type Config struct {
+		Database DatabaseConfig
+		Verbose  bool
+	}
+
+	var cfg Config
+	var output bytes.Buffer
+
+	set := newFlagSet(DefaultParams())
+	set.SetOutput(&output)
+	assert.NoError(t, utils.WalkStructFields(&cfg, set.bind))
+	set.bindNegations()
+
+	set.Usage()
+
+	expected := "Usage:\n" +
+		"  -p, --database-port uint\n" +
+		"    \tport of the database\n" +
+		"      --[no-]verbose\n"
+
+	assert.Equal(t, expected, output.String())
+}
+
+func TestParse_Negation(t *testing.T) {
+	type Config struct {
+		CanRestart bool `short:"r"`
+		Debug      bool
+		Port       uint
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *Result[Config]
+		err      error
+	}{
+		{
+			name: "negated",
+			args: []string{"--no-can-restart", "--debug"},
+			expected: &Result[Config]{
+				Config:   &Config{Debug: true},
+				Explicit: [][]string{{"CanRestart"}, {"Debug"}},
+			},
+		}, {
+			name: "explicit-false",
+			args: []string{"-r=false", "--port", "0"},
+			expected: &Result[Config]{
+				Config:   &Config{},
+				Explicit: [][]string{{"CanRestart"}},
+			},
+		}, {
+			name: "conflict",
+			args: []string{"--can-restart", "--no-can-restart"},
+			err:  NewConflictingFlags("can-restart", "no-can-restart"),
+		}, {
+			name: "conflict-short",
+			args: []string{"--no-can-restart", "-r"},
+			err:  NewConflictingFlags("can-restart", "no-can-restart"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Args = tc.args
+
+			result, err := ParseResult[Config](params)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestParse_NegationCollision(t *testing.T) {
+	type CacheFirst struct {
+		Cache   bool
+		NoCache bool
+	}
+
+	type NoCacheFirst struct {
+		NoCache bool
+		Cache   bool
+	}
+
+	params := DefaultParams()
+	params.Args = []string{"-cache", "-no-cache"}
+
+	first, err := ParseResult[CacheFirst](params)
+	assert.NoError(t, err)
+	assert.Equal(t, &CacheFirst{Cache: true, NoCache: true}, first.Config)
+
+	second, err := ParseResult[NoCacheFirst](params)
+	assert.NoError(t, err)
+	assert.Equal(t, &NoCacheFirst{Cache: true, NoCache: true}, second.Config)
+}
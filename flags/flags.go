@@ -2,104 +2,198 @@ package flags
 
 import (
 	"flag"
-	"fmt"
+	"os"
 	"reflect"
 	"strings"
 
 	"github.com/andrew528i/yacl/utils"
 )
 
+// DefaultDelimiter separates words of flag names.
 var DefaultDelimiter = "-"
+var DefaultNaming = utils.KebabCase
+var DefaultCompletion = false
+
+// Deprecated: use DefaultNaming instead.
 var DefaultFieldPathFormatFunc = func(fieldPath []string) {
 	for i := 0; i < len(fieldPath); i++ {
-		fieldName := strings.Join(utils.CamelCaseToSlice(fieldPath[i]), DefaultDelimiter)
-		fieldPath[i] = strings.ToLower(fieldName)
+		fieldPath[i] = utils.KebabCase.Name(utils.SplitWords(fieldPath[i]), DefaultDelimiter)
 	}
 }
 
 type Params struct {
-	Delimiter           string
+	Delimiter string
+	Naming    utils.NamingStrategy
+
+	// Deprecated: use Naming instead.
 	FieldPathFormatFunc func([]string)
+
+	// Completion binds the hidden CompletionFlag.
+	Completion bool
+
+	// Args holds arguments without the program name, os.Args[1:] if nil.
+	Args []string
 }
 
 func DefaultParams() *Params {
 	return &Params{
-		Delimiter:           DefaultDelimiter,
-		FieldPathFormatFunc: DefaultFieldPathFormatFunc,
+		Delimiter:  DefaultDelimiter,
+		Naming:     DefaultNaming,
+		Completion: DefaultCompletion,
 	}
 }
 
+// Result holds the config parsed from arguments along with explicitly set bool fields.
+type Result[T any] struct {
+	Config   *T
+	Explicit [][]string
+
+	// Command is the name of the selected subcommand, if any.
+	Command string
+}
+
 func Parse[T any](params *Params) (*T, error) {
+	result, err := ParseResult[T](params)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Config, nil
+}
+
+func ParseResult[T any](params *Params) (*Result[T], error) {
 	var cfg T
-	callback := func(fieldPath []string, value reflect.Value, tag *reflect.StructTag) error {
-		var flagName string
-		fieldPathCopy := make([]string, len(fieldPath))
-		copy(fieldPathCopy, fieldPath)
-
-		if tag != nil && tag.Get("yacl") != "" {
-			// TODO: add description and default values parsing from tag
-			flagName = tag.Get("yacl")
-		} else {
-			if params.FieldPathFormatFunc != nil {
-				params.FieldPathFormatFunc(fieldPathCopy)
-			}
-
-			flagName = strings.Join(fieldPathCopy, params.Delimiter)
-		}
 
-		switch value.Kind() {
-		case reflect.String:
-			bindStringFlag(flagName, value)
+	set := newFlagSet(params)
+	set.commands = commandFields(reflect.TypeOf(cfg))
+	flag.CommandLine = set.FlagSet
+
+	err := utils.WalkStructFields[T](&cfg, set.bind)
+	if err != nil {
+		return nil, err
+	}
 
-		case reflect.Bool:
-			bindBoolFlag(flagName, value)
+	set.bindNegations()
 
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			bindUintFlag(flagName, value)
+	if err = set.checkPositionals(); err != nil {
+		return nil, err
+	}
 
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			bindIntFlag(flagName, value)
+	// The completion flag isn't listed in usage, so it stays hidden
+	var shell string
+	if params.Completion && set.Lookup(CompletionFlag) == nil {
+		set.StringVar(&shell, CompletionFlag, "", "print the completion script of the shell")
+	}
 
-		case reflect.Float64:
-			bindFloat64Flag(flagName, value)
+	args := params.Args
+	if args == nil {
+		args = os.Args[1:]
+	}
 
-		case reflect.Slice:
-			elemKind := value.Type().Elem().Kind()
-			switch elemKind {
-			case reflect.String:
-				bindStringSliceFlag(flagName, value)
+	if err = set.parse(args); err != nil {
+		return nil, err
+	}
+
+	if shell != "" {
+		printCompletion[T](params, shell)
+	}
 
-			case reflect.Bool:
-				bindBoolSliceFlag(flagName, value)
+	result := &Result[T]{Config: &cfg}
 
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				bindUintSliceFlag(flagName, value)
+	// A subcommand can't follow --
+	terminated := set.NArg() < len(args) && args[len(args)-set.NArg()-1] == "--"
 
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				bindIntSliceFlag(flagName, value)
+	command, ok := set.lookupCommand(set.Arg(0))
+	ok = ok && !terminated
 
-			case reflect.Float64:
-				bindFloat64SliceFlag(flagName, value)
+	if len(set.commands) > 0 && set.NArg() > 0 && !ok && !set.hasPositionals() {
+		return nil, set.unknownCommand(set.Arg(0))
+	}
 
-			default:
-				panic(fmt.Sprintf("slice type not supported: `%s`", value.Type().Name()))
-			}
+	if ok {
+		commandSet := newCommandFlagSet(set, command)
 
-		default:
-			panic(fmt.Sprintf("type not supported: `%s`", value.Type().Name()))
+		if err = utils.WalkStructFields[T](&cfg, commandSet.bind); err != nil {
+			return nil, err
 		}
 
-		return nil
-	}
+		commandSet.bindNegations()
 
-	flag.CommandLine = flag.NewFlagSet("", flag.ExitOnError)
+		if err = commandSet.checkPositionals(); err != nil {
+			return nil, err
+		}
 
-	err := utils.WalkStruct[T](&cfg, callback)
-	if err != nil {
+		if err = commandSet.parseCommand(set.Args()[1:]); err != nil {
+			return nil, err
+		}
+
+		if err = commandSet.setPositionals(commandSet.Args()); err != nil {
+			return nil, err
+		}
+
+		result.Command = commandName(command)
+		result.Explicit = append(result.Explicit, commandSet.explicit()...)
+	} else if err = set.setPositionals(set.Args()); err != nil {
 		return nil, err
 	}
 
-	flag.Parse()
+	result.Explicit = append(result.Explicit, set.explicit()...)
+
+	return result, nil
+}
+
+// FlagName returns the name of the flag bound to the last of fields.
+func FlagName(params *Params, fields []reflect.StructField) string {
+	if isPositional(fields[len(fields)-1]) {
+		return ""
+	}
+
+	if len(fields) > 1 && commandName(fields[0]) != "" {
+		fields = fields[1:]
+	}
+
+	return fieldFlagName(params, fields)
+}
+
+// ArgName returns the positional argument bound to the last of fields.
+func ArgName(params *Params, fields []reflect.StructField) string {
+	field := fields[len(fields)-1]
+	if !isPositional(field) {
+		return ""
+	}
+
+	if len(fields) > 1 && commandName(fields[0]) != "" {
+		fields = fields[1:]
+	}
+
+	if _, ok := field.Tag.Lookup("args"); ok {
+		return "<" + positionalName(params, fields) + ">..."
+	}
+
+	return "<" + positionalName(params, fields) + ">"
+}
+
+func fieldFlagName(params *Params, fields []reflect.StructField, prefix ...string) string {
+	field := fields[len(fields)-1]
+
+	if name := utils.TagName(field.Tag, "yacl"); name != "" && utils.TagName(field.Tag, "flag") == "" {
+		// TODO: add description and default values parsing from tag
+		return strings.Join(append(prefix, name), "-")
+	}
+
+	if params.FieldPathFormatFunc != nil {
+		fieldPath := utils.FieldPath(fields)
+		params.FieldPathFormatFunc(fieldPath)
+
+		delimiter := params.Delimiter
+		if delimiter == "" {
+			delimiter = "-"
+		}
+
+		return strings.Join(append(prefix, fieldPath...), delimiter)
+	}
+
+	words := append(prefix, utils.FieldWords(fields, "flag", "")...)
 
-	return &cfg, nil
+	return params.Naming.Name(words, params.Delimiter)
 }
@@ -1,7 +1,10 @@
 package flags
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParse_UnsupportedTypes(t *testing.T) {
@@ -43,3 +46,58 @@ func TestParse_UnsupportedTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_Tags(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname string `flag:"host"`
+		URL      string `flag:"/database-url"`
+		Port     uint
+	}
+
+	type Config struct {
+		Database DatabaseConfig `flag:"db"`
+	}
+
+	params := DefaultParams()
+	params.Args = []string{"-db-host", "localhost", "-database-url", "postgres://localhost", "-db-port", "5432"}
+
+	cfg, err := Parse[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, DatabaseConfig{Hostname: "localhost", URL: "postgres://localhost", Port: 5432}, cfg.Database)
+}
+
+func TestFlagName(t *testing.T) {
+	type ServeConfig struct {
+		Port uint
+	}
+
+	type Config struct {
+		Serve  ServeConfig `cmd:"serve"`
+		Source string      `arg:"0"`
+		Log    struct {
+			Level string
+		}
+	}
+
+	typ := reflect.TypeOf(Config{})
+	serve := typ.Field(0)
+	log := typ.Field(2)
+
+	assert.Equal(t, "port", FlagName(DefaultParams(), []reflect.StructField{serve, serve.Type.Field(0)}))
+	assert.Equal(t, "", FlagName(DefaultParams(), []reflect.StructField{typ.Field(1)}))
+	assert.Equal(t, "log-level", FlagName(DefaultParams(), []reflect.StructField{log, log.Type.Field(0)}))
+}
+
+func TestArgName(t *testing.T) {
+	type Config struct {
+		Source string   `arg:"0"`
+		Files  []string `args:"files"`
+		Port   uint
+	}
+
+	typ := reflect.TypeOf(Config{})
+
+	assert.Equal(t, "<source>", ArgName(DefaultParams(), []reflect.StructField{typ.Field(0)}))
+	assert.Equal(t, "<files>...", ArgName(DefaultParams(), []reflect.StructField{typ.Field(1)}))
+	assert.Equal(t, "", ArgName(DefaultParams(), []reflect.StructField{typ.Field(2)}))
+}
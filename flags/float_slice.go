@@ -7,8 +7,8 @@ import (
 	"strconv"
 )
 
-func bindFloat64SliceFlag(flagName string, value reflect.Value) {
-	flag.Var(newFloat64SliceValue(value), flagName, "")
+func bindFloat64SliceFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newFloat64SliceValue(value), flagName, usage)
 }
 
 type float64SliceValue struct {
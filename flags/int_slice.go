@@ -7,8 +7,8 @@ import (
 	"strconv"
 )
 
-func bindIntSliceFlag(flagName string, value reflect.Value) {
-	flag.Var(newIntSliceValue(value), flagName, "")
+func bindIntSliceFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newIntSliceValue(value), flagName, usage)
 }
 
 type intSliceValue struct {
@@ -0,0 +1,43 @@
+package flags
+
+import (
+	"flag"
+	"reflect"
+	"strconv"
+)
+
+func bindNegatedBoolFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newNegatedBoolValue(value), flagName, usage)
+}
+
+// negatedBoolValue sets the bool field to the opposite value.
+type negatedBoolValue struct {
+	value reflect.Value
+}
+
+func newNegatedBoolValue(v reflect.Value) *negatedBoolValue {
+	return &negatedBoolValue{v}
+}
+
+func (s *negatedBoolValue) String() string {
+	if !s.value.IsValid() {
+		return "false"
+	}
+
+	return strconv.FormatBool(!s.value.Bool())
+}
+
+func (s *negatedBoolValue) Set(value string) error {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+
+	s.value.SetBool(!b)
+
+	return nil
+}
+
+func (s *negatedBoolValue) IsBoolFlag() bool {
+	return true
+}
@@ -0,0 +1,168 @@
+package flags
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/andrew528i/yacl/utils"
+)
+
+// positional is a field bound to positional arguments by arg or args tag.
+type positional struct {
+	name      string
+	index     int
+	required  bool
+	fieldPath []string
+	value     reflect.Value
+}
+
+func isPositional(field reflect.StructField) bool {
+	_, isArg := field.Tag.Lookup("arg")
+	_, isArgs := field.Tag.Lookup("args")
+
+	return isArg || isArgs
+}
+
+// bindPositional binds the field tagged with arg or args.
+func (s *flagSet) bindPositional(fields []reflect.StructField, value reflect.Value) error {
+	field := fields[len(fields)-1]
+	p := &positional{
+		name:      positionalName(s.params, s.nameFields(fields)),
+		fieldPath: utils.FieldPath(fields),
+		value:     value,
+	}
+
+	if _, ok := field.Tag.Lookup("args"); ok {
+		if value.Kind() != reflect.Slice {
+			return NewInvalidTag(field.Name, "args", "the field must be a slice")
+		}
+
+		if s.rest != nil {
+			return NewInvalidTag(field.Name, "args", fmt.Sprintf("already used by <%v>", s.rest.name))
+		}
+
+		p.required = utils.HasTagOption(field.Tag, "args", "required")
+		s.rest = p
+
+		return nil
+	}
+
+	index, err := strconv.Atoi(utils.TagName(field.Tag, "arg"))
+	if err != nil || index < 0 {
+		return NewInvalidTag(field.Name, "arg", fmt.Sprintf("%v is not an index of the argument", field.Tag.Get("arg")))
+	}
+
+	p.index = index
+	p.required = !utils.HasTagOption(field.Tag, "arg", "optional")
+	s.positionals = append(s.positionals, p)
+
+	sort.SliceStable(s.positionals, func(i, j int) bool {
+		return s.positionals[i].index < s.positionals[j].index
+	})
+
+	return nil
+}
+
+// checkPositionals fails unless indexes of arg tags go from 0 without gaps.
+func (s *flagSet) checkPositionals() error {
+	for i, p := range s.positionals {
+		if p.index != i {
+			return NewInvalidTag(p.fieldPath[len(p.fieldPath)-1], "arg", fmt.Sprintf("indexes must be sequential from 0, <%v> has %d", p.name, p.index))
+		}
+	}
+
+	return nil
+}
+
+// positionalName returns the name of the argument shown in the usage.
+func positionalName(params *Params, fields []reflect.StructField) string {
+	if name := utils.TagName(fields[len(fields)-1].Tag, "args"); name != "" {
+		return name
+	}
+
+	return fieldFlagName(params, fields)
+}
+
+// hasPositionals reports whether any field is bound to positional arguments.
+func (s *flagSet) hasPositionals() bool {
+	return len(s.positionals) > 0 || s.rest != nil
+}
+
+func (s *flagSet) setPositionals(args []string) error {
+	if !s.hasPositionals() {
+		return nil
+	}
+
+	for i, p := range s.positionals {
+		if i >= len(args) {
+			if p.required {
+				return NewMissingArgument(p.name)
+			}
+
+			continue
+		}
+
+		if err := utils.SetFromString(p.value, args[i]); err != nil {
+			return NewInvalidArgument(p.name, args[i], err)
+		}
+	}
+
+	rest := make([]string, 0)
+	if len(args) > len(s.positionals) {
+		rest = args[len(s.positionals):]
+	}
+
+	if s.rest == nil {
+		if len(rest) > 0 {
+			return NewUnexpectedArgument(rest[0])
+		}
+
+		return nil
+	}
+
+	if len(rest) == 0 {
+		if s.rest.required {
+			return NewMissingArgument(s.rest.name)
+		}
+
+		return nil
+	}
+
+	slice := reflect.MakeSlice(s.rest.value.Type(), len(rest), len(rest))
+
+	for i, arg := range rest {
+		if err := utils.SetFromString(slice.Index(i), arg); err != nil {
+			return NewInvalidArgument(s.rest.name, arg, err)
+		}
+	}
+
+	s.rest.value.Set(slice)
+
+	return nil
+}
+
+// synopsis describes positional arguments, e.g. <source> [<files>...].
+func (s *flagSet) synopsis() string {
+	parts := []string{"[flags]"}
+
+	for _, p := range s.positionals {
+		if p.required {
+			parts = append(parts, "<"+p.name+">")
+		} else {
+			parts = append(parts, "[<"+p.name+">]")
+		}
+	}
+
+	if s.rest != nil {
+		if s.rest.required {
+			parts = append(parts, "<"+s.rest.name+">...")
+		} else {
+			parts = append(parts, "[<"+s.rest.name+">...]")
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,162 @@
+package flags
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/andrew528i/yacl/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Positional(t *testing.T) {
+	type Config struct {
+		Force  bool     `short:"f"`
+		Source string   `arg:"0"`
+		Count  uint     `arg:"1,optional"`
+		Files  []string `args:"files"`
+	}
+
+	_, numErr := strconv.ParseUint("many", 10, 64)
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *Config
+		err      error
+	}{
+		{
+			name:     "required",
+			args:     []string{"-f", "src"},
+			expected: &Config{Force: true, Source: "src"},
+		}, {
+			name:     "optional",
+			args:     []string{"src", "3"},
+			expected: &Config{Source: "src", Count: 3},
+		}, {
+			name:     "rest",
+			args:     []string{"--", "-src", "3", "a.txt", "b.txt"},
+			expected: &Config{Source: "-src", Count: 3, Files: []string{"a.txt", "b.txt"}},
+		}, {
+			name: "missing",
+			args: []string{"-f"},
+			err:  NewMissingArgument("source"),
+		}, {
+			name: "invalid",
+			args: []string{"src", "many"},
+			err:  NewInvalidArgument("count", "many", numErr),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Args = tc.args
+
+			cfg, err := Parse[Config](params)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, cfg)
+		})
+	}
+}
+
+func TestParse_PositionalCommand(t *testing.T) {
+	type CopyConfig struct {
+		Source string `arg:"0"`
+		Target string `arg:"1"`
+	}
+
+	type Config struct {
+		Copy CopyConfig `cmd:"copy"`
+	}
+
+	params := DefaultParams()
+	params.Args = []string{"copy", "a.txt", "b.txt"}
+
+	result, err := ParseResult[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, CopyConfig{Source: "a.txt", Target: "b.txt"}, result.Config.Copy)
+	assert.Empty(t, result.Explicit)
+
+	params.Args = []string{"copy", "a.txt", "b.txt", "c.txt"}
+
+	_, err = ParseResult[Config](params)
+	assert.Equal(t, NewUnexpectedArgument("c.txt"), err)
+}
+
+func TestParse_PositionalTags(t *testing.T) {
+	type NotSlice struct {
+		Files string `args:"files"`
+	}
+
+	type TwoRests struct {
+		Files []string `args:"files"`
+		Dirs  []string `args:"dirs"`
+	}
+
+	type NotIndex struct {
+		Source string `arg:"first"`
+	}
+
+	type Gap struct {
+		Source string `arg:"0"`
+		Target string `arg:"2"`
+	}
+
+	params := DefaultParams()
+	params.Args = []string{}
+
+	_, err := Parse[NotSlice](params)
+	assert.Equal(t, NewInvalidTag("Files", "args", "the field must be a slice"), err)
+
+	_, err = Parse[TwoRests](params)
+	assert.Equal(t, NewInvalidTag("Dirs", "args", "already used by <files>"), err)
+
+	_, err = Parse[NotIndex](params)
+	assert.Equal(t, NewInvalidTag("Source", "arg", "first is not an index of the argument"), err)
+
+	_, err = Parse[Gap](params)
+	assert.Equal(t, NewInvalidTag("Target", "arg", "indexes must be sequential from 0, <target> has 2"), err)
+}
+
+func TestParse_PositionalMatchingCommand(t *testing.T) {
+	type Config struct {
+		Source string   `arg:"0,optional"`
+		Serve  struct{} `cmd:"serve"`
+	}
+
+	params := DefaultParams()
+	params.Args = []string{"serve"}
+
+	result, err := ParseResult[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, "serve", result.Command)
+	assert.Equal(t, "", result.Config.Source)
+
+	params.Args = []string{"--", "serve"}
+
+	result, err = ParseResult[Config](params)
+	assert.NoError(t, err)
+	assert.Equal(t, "", result.Command)
+	assert.Equal(t, "serve", result.Config.Source)
+}
+
+func TestFlagSet_PositionalUsage(t *testing.T) {
+	type Config struct {
+		Source string   `arg:"0"`
+		Target string   `arg:"1,optional"`
+		Files  []string `args:"files,required"`
+	}
+
+	var cfg Config
+	var output bytes.Buffer
+
+	set := newFlagSet(DefaultParams())
+	set.SetOutput(&output)
+	assert.NoError(t, utils.WalkStructFields(&cfg, set.bind))
+	set.bindNegations()
+
+	set.Usage()
+
+	assert.Equal(t, "Usage: [flags] <source> [<target>] <files>...\n", output.String())
+}
@@ -6,22 +6,22 @@ import (
 	"unsafe"
 )
 
-func bindStringFlag(flagName string, value reflect.Value) {
-	flag.StringVar(value.Addr().Interface().(*string), flagName, "", "")
+func bindStringFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.StringVar((*string)(unsafe.Pointer(value.Addr().Pointer())), flagName, "", usage)
 }
 
-func bindBoolFlag(flagName string, value reflect.Value) {
-	flag.BoolVar(value.Addr().Interface().(*bool), flagName, false, "")
+func bindBoolFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.BoolVar((*bool)(unsafe.Pointer(value.Addr().Pointer())), flagName, false, usage)
 }
 
-func bindUintFlag(flagName string, value reflect.Value) {
-	flag.UintVar((*uint)(unsafe.Pointer(value.Addr().Pointer())), flagName, 0, "")
+func bindUintFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.UintVar((*uint)(unsafe.Pointer(value.Addr().Pointer())), flagName, 0, usage)
 }
 
-func bindIntFlag(flagName string, value reflect.Value) {
-	flag.IntVar((*int)(unsafe.Pointer(value.Addr().Pointer())), flagName, 0, "")
+func bindIntFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.IntVar((*int)(unsafe.Pointer(value.Addr().Pointer())), flagName, 0, usage)
 }
 
-func bindFloat64Flag(flagName string, value reflect.Value) {
-	flag.Float64Var((*float64)(unsafe.Pointer(value.Addr().Pointer())), flagName, .0, "")
+func bindFloat64Flag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Float64Var((*float64)(unsafe.Pointer(value.Addr().Pointer())), flagName, .0, usage)
 }
@@ -6,8 +6,8 @@ import (
 	"reflect"
 )
 
-func bindStringSliceFlag(flagName string, value reflect.Value) {
-	flag.Var(newStringSliceValue(value), flagName, "")
+func bindStringSliceFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newStringSliceValue(value), flagName, usage)
 }
 
 type stringSliceValue struct {
@@ -23,7 +23,7 @@ func (s *stringSliceValue) String() string {
 }
 
 func (s *stringSliceValue) Set(value string) error {
-	s.value.Set(reflect.Append(s.value, reflect.ValueOf(value)))
+	s.value.Set(reflect.Append(s.value, reflect.ValueOf(value).Convert(s.value.Type().Elem())))
 
 	return nil
 }
@@ -7,8 +7,8 @@ import (
 	"strconv"
 )
 
-func bindUintSliceFlag(flagName string, value reflect.Value) {
-	flag.Var(newUintSliceValue(value), flagName, "")
+func bindUintSliceFlag(set *flag.FlagSet, flagName, usage string, value reflect.Value) {
+	set.Var(newUintSliceValue(value), flagName, usage)
 }
 
 type uintSliceValue struct {
@@ -1,9 +1,11 @@
 package yacl
 
 import (
+	"github.com/andrew528i/yacl/dir"
 	"github.com/andrew528i/yacl/env"
 	"github.com/andrew528i/yacl/file"
 	"github.com/andrew528i/yacl/flags"
+	"github.com/andrew528i/yacl/utils"
 )
 
 func SetEnvPrefix(prefix string) {
@@ -14,14 +16,51 @@ func SetEnvDelimiter(delimiter string) {
 	env.DefaultDelimiter = delimiter
 }
 
+func SetEnvNaming(naming utils.NamingStrategy) {
+	env.DefaultNaming = naming
+}
+
+func SetEnvUnknownPolicy(policy env.UnknownPolicy) {
+	env.DefaultUnknownPolicy = policy
+}
+
 func SetFilePath(path string) {
 	file.DefaultPath = path
 }
 
+func SetDirPath(path string) {
+	dir.DefaultPath = path
+}
+
 func SetFilename(filename string) {
 	file.DefaultFilename = filename
 }
 
+func SetFileInterpolation(v bool) {
+	file.DefaultInterpolate = v
+}
+
+func SetFileDotenv(v bool) {
+	file.DefaultDotenv = v
+}
+
+func SetFileStrict(v bool) {
+	file.DefaultStrict = v
+}
+
+func SetFileNaming(naming utils.NamingStrategy) {
+	file.DefaultNaming = naming
+	dir.DefaultNaming = naming
+}
+
 func SetFlagDelimiter(delimiter string) {
 	flags.DefaultDelimiter = delimiter
 }
+
+func SetFlagNaming(naming utils.NamingStrategy) {
+	flags.DefaultNaming = naming
+}
+
+func SetFlagCompletion(v bool) {
+	flags.DefaultCompletion = v
+}
@@ -5,9 +5,11 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/andrew528i/yacl/dir"
 	"github.com/andrew528i/yacl/env"
 	"github.com/andrew528i/yacl/file"
 	"github.com/andrew528i/yacl/flags"
+	"github.com/andrew528i/yacl/utils"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
 )
@@ -94,3 +96,91 @@ func TestSetFlagDelimiter(t *testing.T) {
 	os.Args = normalArgs
 	SetFlagDelimiter("-")
 }
+
+func TestSetDirPath(t *testing.T) {
+	type Config struct {
+		DatabasePassword string
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "DATABASE_PASSWORD"), []byte("secret\n"), 0600))
+
+	SetDirPath(tempDir)
+
+	cfg, err := dir.Parse[Config](dir.DefaultParams())
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", cfg.DatabasePassword)
+
+	SetDirPath("")
+}
+
+func TestSetFileStrict(t *testing.T) {
+	type Config struct {
+		Hostname string
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("hostnme: localhost\n"), 0644))
+
+	SetFileStrict(true)
+
+	_, err := file.ParseYAML[Config](file.DefaultParams(tempDir))
+	assert.IsType(t, &file.UnknownKey{}, err)
+
+	SetFileStrict(false)
+}
+
+func TestSetFileNaming(t *testing.T) {
+	type Config struct {
+		CanRestart bool
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("can-restart: true\n"), 0644))
+
+	SetFileNaming(utils.KebabCase)
+
+	cfg, err := file.ParseYAML[Config](file.DefaultParams(tempDir))
+	assert.NoError(t, err)
+	assert.True(t, cfg.CanRestart)
+
+	SetFileNaming(utils.SnakeCase)
+}
+
+func TestSetEnvNaming(t *testing.T) {
+	type Config struct {
+		OAuth2Token string
+	}
+
+	assert.NoError(t, os.Setenv("oauth2_token", "token"))
+
+	SetEnvNaming(utils.SnakeCase)
+
+	cfg, err := env.Parse[Config](env.DefaultParams())
+	assert.NoError(t, err)
+	assert.Equal(t, "token", cfg.OAuth2Token)
+
+	os.Clearenv()
+	SetEnvNaming(utils.ScreamingSnakeCase)
+}
+
+func TestSetFlagNaming(t *testing.T) {
+	type Config struct {
+		IPv6Addr string
+	}
+
+	SetFlagNaming(utils.CamelCase)
+
+	normalArgs := os.Args
+	os.Args = []string{
+		"cmd",
+		"-ipv6Addr", "::1",
+	}
+
+	cfg, err := flags.Parse[Config](flags.DefaultParams())
+	assert.NoError(t, err)
+	assert.Equal(t, "::1", cfg.IPv6Addr)
+
+	os.Args = normalArgs
+	SetFlagNaming(utils.KebabCase)
+}
@@ -1,65 +1,21 @@
 package yacl
 
-import (
-	"github.com/andrew528i/yacl/env"
-	"github.com/andrew528i/yacl/file"
-	"github.com/andrew528i/yacl/flags"
-	"github.com/andrew528i/yacl/utils"
-)
-
 func Parse[T any](defaultConfigs ...*T) (*T, error) {
-	var cfg T
-
-	// First use default configs if they provided
-	for _, defaultConfig := range defaultConfigs {
-		utils.MergeStruct(&cfg, defaultConfig)
-	}
-
-	// Merge config values from yaml file
-	yamlCfg, err := file.ParseYAML[T](file.DefaultParams())
-	if err != nil {
-		if _, ok := err.(*file.NotFound); !ok {
-			return nil, err
-		}
-	} else {
-		utils.MergeStruct(&cfg, yamlCfg)
-	}
-
-	// Merge config values from json file
-	jsonCfg, err := file.ParseJSON[T](file.DefaultParams())
-	if err != nil {
-		if _, ok := err.(*file.NotFound); !ok {
-			return nil, err
-		}
-	} else {
-		utils.MergeStruct(&cfg, jsonCfg)
-	}
-
-	// Merge config values from json file
-	binaryCfg, err := file.ParseBinary[T](file.DefaultParams())
-	if err != nil {
-		if _, ok := err.(*file.NotFound); !ok {
-			return nil, err
-		}
-	} else {
-		utils.MergeStruct(&cfg, binaryCfg)
-	}
-
-	// Merge config values from env
-	envCfg, err := env.Parse[T](env.DefaultParams())
-	if err != nil {
-		return nil, err
-	}
+	return New[T]().Parse(defaultConfigs...)
+}
 
-	utils.MergeStruct(&cfg, envCfg)
+func Completion[T any](shell, program string) (string, error) {
+	return New[T]().Completion(shell, program)
+}
 
-	// Merge config values from command line interface
-	flagCfg, err := flags.Parse[T](flags.DefaultParams())
-	if err != nil {
-		return nil, err
-	}
+func Markdown[T any](defaultConfigs ...*T) (string, error) {
+	return New[T]().Markdown(defaultConfigs...)
+}
 
-	utils.MergeStruct(&cfg, flagCfg)
+func ManPage[T any](program string, defaultConfigs ...*T) (string, error) {
+	return New[T]().ManPage(program, defaultConfigs...)
+}
 
-	return &cfg, nil
+func JSONSchema[T any](defaultConfigs ...*T) ([]byte, error) {
+	return New[T]().JSONSchema(defaultConfigs...)
 }
@@ -0,0 +1,150 @@
+package yacl
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/andrew528i/yacl/file"
+	"github.com/andrew528i/yacl/utils"
+)
+
+var referenceRegexp = regexp.MustCompile(`\$?\$\{([^}]*\.[^}]*)\}`)
+
+type referenceField struct {
+	fieldPath []string
+	value     reflect.Value
+	secret    bool
+}
+
+type referenceResolver struct {
+	fields map[string]*referenceField
+
+	// roots holds first segments of field paths
+	roots map[string]bool
+
+	// resolving holds fields being resolved in order to detect cycles
+	resolving []*referenceField
+	resolved  map[*referenceField]bool
+}
+
+// resolveReferences replaces ${server.host} with the value of the referenced field.
+func resolveReferences[T any](cfg *T, params *file.Params) error {
+	resolver := &referenceResolver{
+		fields:   make(map[string]*referenceField),
+		roots:    make(map[string]bool),
+		resolved: make(map[*referenceField]bool),
+	}
+
+	fields := make([]*referenceField, 0)
+
+	callback := func(structFields []reflect.StructField, value reflect.Value) error {
+		field := &referenceField{
+			fieldPath: utils.FieldPath(structFields),
+			value:     value,
+			secret:    isSecret(structFields),
+		}
+
+		fields = append(fields, field)
+		resolver.fields[strings.Join(field.fieldPath, ".")] = field
+		resolver.roots[field.fieldPath[0]] = true
+
+		// Fields excluded from files are referred by Go field names only
+		if key := file.Key(params, structFields); key != "" {
+			root, _, _ := strings.Cut(key, ".")
+			resolver.fields[key] = field
+			resolver.roots[root] = true
+		}
+
+		return nil
+	}
+
+	if err := utils.WalkStructFields(cfg, callback); err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		if err := resolver.resolve(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *referenceResolver) resolve(field *referenceField) error {
+	if s.resolved[field] || field.value.Kind() != reflect.String || !field.value.CanSet() {
+		return nil
+	}
+
+	for i, f := range s.resolving {
+		if f == field {
+			return NewReferenceCycle(s.cycle(s.resolving[i:]))
+		}
+	}
+
+	s.resolving = append(s.resolving, field)
+	defer func() {
+		s.resolving = s.resolving[:len(s.resolving)-1]
+	}()
+
+	var err error
+
+	result := referenceRegexp.ReplaceAllStringFunc(field.value.String(), func(expr string) string {
+		if err != nil {
+			return expr
+		}
+
+		if strings.HasPrefix(expr, "$$") {
+			return expr[1:]
+		}
+
+		reference := expr[2 : len(expr)-1]
+
+		target, ok := s.fields[reference]
+		if !ok {
+			root, _, _ := strings.Cut(reference, ".")
+			if s.roots[root] {
+				err = NewUnknownReference(field.fieldPath, reference)
+			}
+
+			return expr
+		}
+
+		if target.secret && !field.secret {
+			err = NewSecretReference(field.fieldPath, reference)
+
+			return expr
+		}
+
+		if err = s.resolve(target); err != nil {
+			return expr
+		}
+
+		if target.value.Kind() == reflect.String {
+			return target.value.String() // raw value of Secret as well
+		}
+
+		return fmt.Sprint(target.value.Interface())
+	})
+
+	if err != nil {
+		return err
+	}
+
+	field.value.SetString(result)
+	s.resolved[field] = true
+
+	return nil
+}
+
+func (s *referenceResolver) cycle(fields []*referenceField) []string {
+	cycle := make([]string, 0, len(fields)+1)
+
+	for _, field := range fields {
+		cycle = append(cycle, strings.Join(field.fieldPath, "."))
+	}
+
+	return append(cycle, cycle[0])
+}
@@ -0,0 +1,142 @@
+package yacl
+
+import (
+	"os"
+	"testing"
+
+	"github.com/andrew528i/yacl/file"
+	"github.com/andrew528i/yacl/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveReferences(t *testing.T) {
+	type Server struct {
+		Host      string
+		Port      uint
+		PublicURL string
+		Password  Secret
+	}
+
+	type Config struct {
+		Server   Server
+		Callback string
+		Token    Secret
+		Literal  string
+		EnvLike  string
+		Template string
+	}
+
+	cfg := Config{
+		Server: Server{
+			Host:      "example.com",
+			Port:      8443,
+			PublicURL: "https://${server.host}:${server.port}",
+			Password:  "p4ss",
+		},
+		Callback: "${Server.PublicURL}/callback",
+		Token:    "token-${server.password}",
+		Literal:  "$${server.host}",
+		EnvLike:  "${HOME}",
+		Template: "${item.name}",
+	}
+
+	params := file.DefaultParams()
+
+	assert.NoError(t, resolveReferences(&cfg, params))
+	assert.Equal(t, "https://example.com:8443", cfg.Server.PublicURL)
+	assert.Equal(t, "https://example.com:8443/callback", cfg.Callback)
+	assert.Equal(t, Secret("token-p4ss"), cfg.Token)
+	assert.Equal(t, "${server.host}", cfg.Literal)
+	assert.Equal(t, "${HOME}", cfg.EnvLike)
+	assert.Equal(t, "${item.name}", cfg.Template)
+
+	cfg.Callback = "${server.public-url}/callback"
+	params.Naming = utils.KebabCase
+	assert.NoError(t, resolveReferences(&cfg, params))
+	assert.Equal(t, "https://example.com:8443/callback", cfg.Callback)
+}
+
+func TestResolveReferences_FileKeys(t *testing.T) {
+	type Common struct {
+		Scheme string
+	}
+
+	type Server struct {
+		Common `yaml:",inline"`
+		Host   string `yaml:"hostname"`
+	}
+
+	type Config struct {
+		Server Server `yaml:"srv"`
+		URL    string
+	}
+
+	cfg := Config{
+		Server: Server{Common: Common{Scheme: "https"}, Host: "example.com"},
+		URL:    "${srv.scheme}://${srv.hostname}",
+	}
+
+	assert.NoError(t, resolveReferences(&cfg, file.DefaultParams()))
+	assert.Equal(t, "https://example.com", cfg.URL)
+
+	cfg.URL = "${srv.host}"
+	assert.IsType(t, &UnknownReference{}, resolveReferences(&cfg, file.DefaultParams()))
+}
+
+func TestResolveReferences_Errors(t *testing.T) {
+	type Node struct {
+		First  string
+		Second string
+		Third  string
+		Key    string `secret:"true"`
+	}
+
+	type Config struct {
+		Node Node
+	}
+
+	cfg := Config{Node: Node{First: "${node.hots}"}}
+	err := resolveReferences(&cfg, file.DefaultParams())
+
+	var unknownErr *UnknownReference
+	assert.ErrorAs(t, err, &unknownErr)
+	assert.Equal(t, []string{"Node", "First"}, unknownErr.FieldPath)
+	assert.Equal(t, "Node.First refers to unknown field ${node.hots}", err.Error())
+
+	cfg = Config{Node: Node{First: "${node.second}", Second: "${node.third}", Third: "x-${node.first}"}}
+	err = resolveReferences(&cfg, file.DefaultParams())
+
+	var cycleErr *ReferenceCycle
+	assert.ErrorAs(t, err, &cycleErr)
+	assert.Equal(t, "reference cycle: Node.First -> Node.Second -> Node.Third -> Node.First", err.Error())
+
+	cfg = Config{Node: Node{First: "auth=${node.key}", Key: "p4ss"}}
+	err = resolveReferences(&cfg, file.DefaultParams())
+
+	var secretErr *SecretReference
+	assert.ErrorAs(t, err, &secretErr)
+	assert.Equal(t, "Node.First refers to secret field ${node.key} without being secret", err.Error())
+}
+
+func TestYACL_ParseReferences(t *testing.T) {
+	type Server struct {
+		Host      string
+		PublicURL string
+	}
+
+	type Config struct {
+		Server Server
+	}
+
+	originalArgs := os.Args
+	os.Args = []string{"cmd", "-server-host", "flag.example.com"}
+
+	cfg, err := New[Config]().Parse(&Config{Server: Server{
+		Host:      "localhost",
+		PublicURL: "https://${server.host}",
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://flag.example.com", cfg.Server.PublicURL)
+
+	os.Args = originalArgs
+}
@@ -0,0 +1,266 @@
+package yacl
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andrew528i/yacl/file"
+	"github.com/andrew528i/yacl/utils"
+)
+
+// SchemaDialect is the JSON Schema draft generated schemas conform to.
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// schemaBuilder builds JSON Schema of YAML config files.
+type schemaBuilder struct {
+	params *file.Params
+
+	// types holds structs being built in order to stop on recursive types
+	types map[reflect.Type]bool
+}
+
+// JSONSchema returns JSON Schema validating YAML config files of T.
+func (s *YACL[T]) JSONSchema(defaultConfigs ...*T) ([]byte, error) {
+	var cfg T
+
+	for _, defaultConfig := range defaultConfigs {
+		utils.MergeStruct(&cfg, defaultConfig)
+	}
+
+	builder := &schemaBuilder{
+		params: s.file,
+		types:  make(map[reflect.Type]bool),
+	}
+
+	schema := builder.build(reflect.ValueOf(cfg), nil, false)
+	schema["$schema"] = SchemaDialect
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// build returns the schema of value with constraints from tags of the field.
+func (s *schemaBuilder) build(value reflect.Value, field *reflect.StructField, secret bool) map[string]any {
+	typ := value.Type()
+	schema := make(map[string]any)
+
+	switch {
+	case typ == durationType:
+		schema["type"] = "string"
+
+	case typ == timeType:
+		schema["type"] = "string"
+		schema["format"] = "date-time"
+
+	case reflect.PointerTo(typ).Implements(textUnmarshalerType):
+		schema["type"] = "string"
+
+	default:
+		s.buildKind(schema, value, secret)
+	}
+
+	if field == nil {
+		return schema
+	}
+
+	s.applyTags(schema, *field)
+
+	// Defaults of structs are set on their fields
+	kind := typ.Kind()
+	if kind == reflect.Pointer {
+		kind = typ.Elem().Kind()
+	}
+
+	if !secret && !value.IsZero() && (kind != reflect.Struct || typ == timeType) {
+		schema["default"] = defaultValue(value)
+	}
+
+	return schema
+}
+
+func (s *schemaBuilder) buildKind(schema map[string]any, value reflect.Value, secret bool) {
+	typ := value.Type()
+
+	switch typ.Kind() {
+	case reflect.String:
+		schema["type"] = "string"
+
+	case reflect.Bool:
+		schema["type"] = "boolean"
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema["type"] = "integer"
+		schema["minimum"] = 0
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		schema["type"] = "integer"
+
+	case reflect.Float32, reflect.Float64:
+		schema["type"] = "number"
+
+	case reflect.Slice, reflect.Array:
+		schema["type"] = "array"
+		schema["items"] = s.build(reflect.Zero(typ.Elem()), nil, secret)
+
+	case reflect.Map:
+		schema["type"] = "object"
+		schema["additionalProperties"] = s.build(reflect.Zero(typ.Elem()), nil, secret)
+
+	case reflect.Pointer:
+		for k, v := range s.build(reflect.Zero(typ.Elem()), nil, secret) {
+			schema[k] = v
+		}
+
+	case reflect.Struct:
+		schema["type"] = "object"
+
+		if s.types[typ] {
+			return // recursive type
+		}
+
+		s.types[typ] = true
+		defer delete(s.types, typ)
+
+		properties := make(map[string]any)
+		required := make([]string, 0)
+		s.buildProperties(properties, &required, value, secret)
+
+		schema["properties"] = properties
+
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+
+		if s.params.Strict {
+			schema["additionalProperties"] = false
+		}
+	}
+}
+
+func (s *schemaBuilder) buildProperties(properties map[string]any, required *[]string, value reflect.Value, secret bool) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldSecret := secret || isSecretField(field)
+		fields := []reflect.StructField{field}
+		key := file.Key(s.params, fields)
+
+		if key == "" && field.Type.Kind() == reflect.Struct && utils.HasTagOption(field.Tag, "yaml", "inline") {
+			if utils.FromSource(fields, utils.SourceFile) && utils.TagName(field.Tag, "yaml") != "-" {
+				s.buildProperties(properties, required, value.Field(i), fieldSecret)
+			}
+
+			continue
+		}
+
+		if key == "" {
+			continue // excluded from files
+		}
+
+		properties[key] = s.build(value.Field(i), &field, fieldSecret)
+
+		if utils.HasTagOption(field.Tag, "yacl", "required") {
+			*required = append(*required, key)
+		}
+	}
+}
+
+// applyTags adds the description, enum and bounds of the field.
+func (s *schemaBuilder) applyTags(schema map[string]any, field reflect.StructField) {
+	if description := field.Tag.Get("description"); description != "" {
+		schema["description"] = description
+	}
+
+	if oneof := strings.Fields(field.Tag.Get("oneof")); len(oneof) > 0 {
+		// Every element of slices is one of the values
+		enumSchema := schema
+		if items, ok := schema["items"].(map[string]any); ok {
+			enumSchema = items
+		}
+
+		enum := make([]any, 0, len(oneof))
+		for _, v := range oneof {
+			enum = append(enum, schemaValue(enumSchema["type"], v))
+		}
+
+		enumSchema["enum"] = enum
+	}
+
+	typ := field.Type
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	// Bounds of durations and other values written as text can't be expressed
+	if typ == durationType || typ == timeType || reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return
+	}
+
+	minKey, maxKey := "minimum", "maximum"
+
+	switch schema["type"] {
+	case "string":
+		minKey, maxKey = "minLength", "maxLength"
+
+	case "array":
+		minKey, maxKey = "minItems", "maxItems"
+
+	case "object":
+		minKey, maxKey = "minProperties", "maxProperties"
+	}
+
+	if v, ok := field.Tag.Lookup("min"); ok {
+		schema[minKey] = schemaValue("number", v)
+	}
+
+	if v, ok := field.Tag.Lookup("max"); ok {
+		schema[maxKey] = schemaValue("number", v)
+	}
+}
+
+// defaultValue returns the value the way it's written in config files.
+func defaultValue(value reflect.Value) any {
+	if value.Type() == durationType {
+		return value.Interface().(time.Duration).String()
+	}
+
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+
+	return value.Interface()
+}
+
+func schemaValue(schemaType any, v string) any {
+	switch schemaType {
+	case "integer", "number":
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i
+		}
+
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+
+	case "boolean":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+
+	return v
+}
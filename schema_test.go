@@ -0,0 +1,82 @@
+package yacl
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONSchema(t *testing.T) {
+	type Common struct {
+		LogLevel string `oneof:"debug info warn" description:"level of logs"`
+	}
+
+	type DatabaseConfig struct {
+		Host     string `yacl:",required"`
+		Port     uint   `max:"65535"`
+		Password Secret
+		Timeout  time.Duration `min:"1s"`
+	}
+
+	type Config struct {
+		Common   `yaml:",inline"`
+		Database DatabaseConfig
+		Hosts    []string `min:"1"`
+		Outputs  []string `oneof:"stdout file"`
+		Labels   map[string]int
+		Debug    bool `yacl:",nofile"`
+	}
+
+	defaultCfg := &Config{
+		Database: DatabaseConfig{Port: 5432, Password: "qwerty", Timeout: 5 * time.Second},
+	}
+
+	data, err := JSONSchema[Config](defaultCfg)
+	assert.NoError(t, err)
+
+	expected := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"log_level": {
+				"type": "string",
+				"description": "level of logs",
+				"enum": ["debug", "info", "warn"]
+			},
+			"database": {
+				"type": "object",
+				"properties": {
+					"host": {"type": "string"},
+					"port": {"type": "integer", "minimum": 0, "maximum": 65535, "default": 5432},
+					"password": {"type": "string"},
+					"timeout": {"type": "string", "default": "5s"}
+				},
+				"required": ["host"]
+			},
+			"hosts": {"type": "array", "items": {"type": "string"}, "minItems": 1},
+			"outputs": {"type": "array", "items": {"type": "string", "enum": ["stdout", "file"]}},
+			"labels": {"type": "object", "additionalProperties": {"type": "integer"}}
+		}
+	}`
+
+	assert.JSONEq(t, expected, string(data))
+}
+
+func TestYACL_JSONSchemaStrict(t *testing.T) {
+	type Config struct {
+		Level int `oneof:"1 2 3"`
+	}
+
+	y := New[Config]()
+	y.SetFileStrict(true)
+
+	data, err := y.JSONSchema()
+	assert.NoError(t, err)
+
+	var schema map[string]any
+	assert.NoError(t, json.Unmarshal(data, &schema))
+	assert.Equal(t, false, schema["additionalProperties"])
+	assert.Equal(t, []any{1.0, 2.0, 3.0}, schema["properties"].(map[string]any)["level"].(map[string]any)["enum"])
+}
@@ -0,0 +1,63 @@
+package yacl
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+var secretType = reflect.TypeOf(Secret(""))
+
+// Secret is a string which is redacted when printed, logged or marshaled.
+type Secret string
+
+func (s Secret) Reveal() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	return Redacted
+}
+
+func (s Secret) GoString() string {
+	return fmt.Sprintf("yacl.Secret(%q)", Redacted)
+}
+
+func (s Secret) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = fmt.Fprint(f, s.GoString())
+
+		return
+	}
+
+	_, _ = fmt.Fprint(f, Redacted)
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Redacted)
+}
+
+func (s Secret) MarshalYAML() (interface{}, error) {
+	return Redacted, nil
+}
+
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(Redacted)
+}
+
+// isSecretField reports whether the field is Secret or tagged with `secret:"true"`.
+func isSecretField(field reflect.StructField) bool {
+	return field.Type == secretType || field.Tag.Get("secret") == "true"
+}
+
+// isSecret reports whether any of fields is secret.
+func isSecret(fields []reflect.StructField) bool {
+	for _, field := range fields {
+		if isSecretField(field) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package yacl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSecret(t *testing.T) {
+	type Database struct {
+		Hostname string
+		Password Secret
+	}
+
+	cfg := Database{Hostname: "localhost", Password: "p4ssw0rd"}
+
+	assert.Equal(t, "p4ssw0rd", cfg.Password.Reveal())
+	assert.Equal(t, "{Hostname:localhost Password:[REDACTED]}", fmt.Sprintf("%+v", cfg))
+	assert.Equal(t, "[REDACTED]", fmt.Sprintf("%s", cfg.Password))
+	assert.Equal(t, `yacl.Database{Hostname:"localhost", Password:yacl.Secret("[REDACTED]")}`, fmt.Sprintf("%#v", cfg))
+
+	jsonBytes, err := json.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Hostname":"localhost","Password":"[REDACTED]"}`, string(jsonBytes))
+
+	yamlBytes, err := yaml.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "hostname: localhost\npassword: '[REDACTED]'\n", string(yamlBytes))
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("connect", "password", cfg.Password)
+	assert.Contains(t, buf.String(), "password=[REDACTED]")
+	assert.NotContains(t, buf.String(), "p4ssw0rd")
+}
+
+func TestSecret_Parse(t *testing.T) {
+	type Config struct {
+		Password Secret
+		Tokens   []Secret
+		APIKey   Secret
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("password: yaml-password"), 0644))
+	assert.NoError(t, os.Setenv("TOKENS", "first,second"))
+
+	originalArgs := os.Args
+	os.Args = []string{"cmd", "-api-key", "flag-key"}
+
+	y := New[Config]()
+	y.AddFilePath(tempDir)
+	cfg, err := y.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, Config{
+		Password: "yaml-password",
+		Tokens:   []Secret{"first", "second"},
+		APIKey:   "flag-key",
+	}, *cfg)
+
+	os.Args = originalArgs
+	os.Clearenv()
+}
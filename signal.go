@@ -0,0 +1,38 @@
+package yacl
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// ReloadOnSIGHUP reloads the config on every SIGHUP until ctx is done.
+func (s *YACL[T]) ReloadOnSIGHUP(ctx context.Context, onError func(error)) error {
+	if s.store.Load() == nil {
+		if _, err := s.Parse(); err != nil {
+			return err
+		}
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(signals)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-signals:
+				if err := s.reload(); err != nil {
+					s.handleReloadError(err, onError)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package yacl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYACL_ReloadOnSIGHUP(t *testing.T) {
+	type Config struct {
+		Endpoint string
+		Region   string
+	}
+
+	tempDir := t.TempDir()
+	tempFile := filepath.Join(tempDir, "config.yaml")
+	assert.NoError(t, os.WriteFile(tempFile, []byte("endpoint: first"), 0644))
+	assert.NoError(t, os.Setenv("REGION", "eu"))
+
+	y := New[Config]()
+	y.SetIgnoreFlags(true)
+	y.AddFilePath(tempDir)
+
+	store, err := y.Load()
+	assert.NoError(t, err)
+
+	ch, unsubscribe := store.Subscribe()
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, y.ReloadOnSIGHUP(ctx, func(err error) {
+		t.Errorf("unexpected reload error: %v", err)
+	}))
+
+	// Files are read again while the environment is taken from the snapshot
+	assert.NoError(t, os.WriteFile(tempFile, []byte("endpoint: second"), 0644))
+	assert.NoError(t, os.Setenv("REGION", "us"))
+
+	process, err := os.FindProcess(os.Getpid())
+	assert.NoError(t, err)
+	assert.NoError(t, process.Signal(syscall.SIGHUP))
+
+	select {
+	case snapshot := <-ch:
+		assert.Equal(t, Config{Endpoint: "second", Region: "eu"}, *snapshot.Config)
+	case <-time.After(time.Second):
+		t.Fatal("config was not reloaded")
+	}
+
+	os.Clearenv()
+}
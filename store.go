@@ -0,0 +1,103 @@
+package yacl
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Snapshot is a config published to a Store along with its version.
+type Snapshot[T any] struct {
+	Config  *T
+	Version uint64
+}
+
+// Store holds the current config for concurrent readers.
+type Store[T any] struct {
+	snapshot atomic.Pointer[Snapshot[T]]
+
+	mu          sync.Mutex
+	subscribers map[chan Snapshot[T]]struct{}
+}
+
+func NewStore[T any](cfg *T) *Store[T] {
+	store := &Store[T]{
+		subscribers: make(map[chan Snapshot[T]]struct{}),
+	}
+
+	if cfg != nil {
+		store.Publish(cfg)
+	}
+
+	return store
+}
+
+// Load returns the current config or nil if nothing was published yet.
+func (s *Store[T]) Load() *T {
+	return s.Snapshot().Config
+}
+
+// Version returns the version of the current config.
+func (s *Store[T]) Version() uint64 {
+	return s.Snapshot().Version
+}
+
+// Snapshot returns the current config along with its version.
+func (s *Store[T]) Snapshot() Snapshot[T] {
+	snapshot := s.snapshot.Load()
+	if snapshot == nil {
+		return Snapshot[T]{}
+	}
+
+	return *snapshot
+}
+
+// Publish replaces the current config and notifies subscribers.
+func (s *Store[T]) Publish(cfg *T) Snapshot[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := Snapshot[T]{
+		Config:  cfg,
+		Version: s.Version() + 1,
+	}
+
+	s.snapshot.Store(&snapshot)
+
+	for ch := range s.subscribers {
+		// Slow subscribers get the latest snapshot only
+		select {
+		case ch <- snapshot:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+
+			ch <- snapshot
+		}
+	}
+
+	return snapshot
+}
+
+// Subscribe returns a channel of published snapshots and a function to unsubscribe.
+func (s *Store[T]) Subscribe() (<-chan Snapshot[T], func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan Snapshot[T], 1)
+	s.subscribers[ch] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			delete(s.subscribers, ch)
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
@@ -0,0 +1,90 @@
+package yacl
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	type Config struct {
+		Hostname string
+	}
+
+	store := NewStore[Config](nil)
+	assert.Nil(t, store.Load())
+	assert.Equal(t, uint64(0), store.Version())
+
+	ch, unsubscribe := store.Subscribe()
+
+	snapshot := store.Publish(&Config{Hostname: "first"})
+	assert.Equal(t, uint64(1), snapshot.Version)
+	assert.Equal(t, "first", store.Load().Hostname)
+	assert.Equal(t, snapshot, <-ch)
+
+	// Slow subscriber gets the latest snapshot only
+	store.Publish(&Config{Hostname: "second"})
+	store.Publish(&Config{Hostname: "third"})
+
+	snapshot = <-ch
+	assert.Equal(t, uint64(3), snapshot.Version)
+	assert.Equal(t, "third", snapshot.Config.Hostname)
+	assert.Equal(t, snapshot, store.Snapshot())
+
+	unsubscribe()
+	unsubscribe()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	store.Publish(&Config{Hostname: "fourth"})
+	assert.Equal(t, uint64(4), store.Version())
+}
+
+func TestStore_ConcurrentLoad(t *testing.T) {
+	type Config struct {
+		Port uint
+	}
+
+	store := NewStore(&Config{Port: 1})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				snapshot := store.Snapshot()
+				assert.Equal(t, uint64(snapshot.Config.Port), snapshot.Version)
+			}
+		}()
+	}
+
+	for port := uint(2); port <= 100; port++ {
+		store.Publish(&Config{Port: port})
+	}
+
+	wg.Wait()
+}
+
+func TestYACL_Load(t *testing.T) {
+	type Config struct {
+		Endpoint string
+	}
+
+	y := New[Config]()
+	y.SetIgnoreFlags(true)
+
+	store, err := y.Load(&Config{Endpoint: "localhost-default"})
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost-default", store.Load().Endpoint)
+	assert.Equal(t, uint64(1), store.Version())
+
+	_, err = y.Parse(&Config{Endpoint: "localhost-second"})
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost-second", store.Load().Endpoint)
+	assert.Equal(t, uint64(2), store.Version())
+}
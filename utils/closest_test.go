@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"database", "hostname", "port", "can_restart"}
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "missing-letter", input: "databse", expected: "database"},
+		{name: "swapped-letters", input: "hsotname", expected: "hostname"},
+		{name: "extra-letter", input: "portt", expected: "port"},
+		{name: "separator", input: "canrestart", expected: "can_restart"},
+		{name: "case", input: "PROT", expected: "port"},
+		{name: "unrelated", input: "timeout", expected: ""},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Closest(tc.input, candidates))
+		})
+	}
+}
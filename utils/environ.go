@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvironMap converts variables in the form "KEY=value" to a map.
+func EnvironMap(environ []string) map[string]string {
+	if environ == nil {
+		environ = os.Environ()
+	}
+
+	vars := make(map[string]string, len(environ))
+
+	for _, v := range environ {
+		key, value, _ := strings.Cut(v, "=")
+		vars[key] = value
+	}
+
+	return vars
+}
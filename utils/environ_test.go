@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironMap(t *testing.T) {
+	vars := EnvironMap([]string{"FIRST=1", "SECOND=a=b", "EMPTY=", "FIRST=2"})
+	assert.Equal(t, map[string]string{"FIRST": "2", "SECOND": "a=b", "EMPTY": ""}, vars)
+
+	assert.NoError(t, os.Setenv("ENVIRON_MAP_TEST", "process"))
+	assert.Equal(t, "process", EnvironMap(nil)["ENVIRON_MAP_TEST"])
+	assert.NoError(t, os.Unsetenv("ENVIRON_MAP_TEST"))
+}
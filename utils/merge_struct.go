@@ -7,6 +7,10 @@ func MergeStruct(dst, src interface{}) {
 	srcValue := reflect.ValueOf(src).Elem()
 
 	for i := 0; i < dstValue.NumField(); i++ {
+		if !dstValue.Type().Field(i).IsExported() {
+			continue
+		}
+
 		dstField := dstValue.Field(i)
 		srcField := srcValue.Field(i)
 
@@ -24,9 +28,25 @@ func MergeStruct(dst, src interface{}) {
 			MergeStruct(dstField.Addr().Interface(), srcField.Addr().Interface())
 
 		default:
-			if srcField.Interface() != reflect.Zero(srcField.Type()).Interface() {
+			// IsZero unlike != works for maps and interfaces holding them
+			if !srcField.IsZero() {
 				dstField.Set(srcField)
 			}
 		}
 	}
 }
+
+// CopyFields sets fields of dst at fieldPaths to values of src, even zero ones.
+func CopyFields(dst, src interface{}, fieldPaths [][]string) {
+	for _, fieldPath := range fieldPaths {
+		dstField := reflect.ValueOf(dst).Elem()
+		srcField := reflect.ValueOf(src).Elem()
+
+		for _, fieldName := range fieldPath {
+			dstField = dstField.FieldByName(fieldName)
+			srcField = srcField.FieldByName(fieldName)
+		}
+
+		dstField.Set(srcField)
+	}
+}
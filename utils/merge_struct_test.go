@@ -65,3 +65,37 @@ func TestMergeStruct(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeStruct_Uncomparable(t *testing.T) {
+	type Config struct {
+		Labels map[string]string
+		Extra  interface{}
+	}
+
+	target := Config{Labels: map[string]string{"env": "dev"}, Extra: []int{1}}
+
+	MergeStruct(&target, &Config{})
+	assert.Equal(t, Config{Labels: map[string]string{"env": "dev"}, Extra: []int{1}}, target)
+
+	MergeStruct(&target, &Config{Labels: map[string]string{"env": "prod"}, Extra: []int{2}})
+	assert.Equal(t, Config{Labels: map[string]string{"env": "prod"}, Extra: []int{2}}, target)
+}
+
+func TestCopyFields(t *testing.T) {
+	type Address struct {
+		Street string
+		Zip    int
+	}
+
+	type Person struct {
+		Name    string
+		Married bool
+		Address Address
+	}
+
+	dst := Person{Name: "John", Married: true, Address: Address{Street: "Main St.", Zip: 10001}}
+	src := Person{Name: "Jane"}
+
+	CopyFields(&dst, &src, [][]string{{"Married"}, {"Address", "Zip"}})
+	assert.Equal(t, Person{Name: "John", Address: Address{Street: "Main St."}}, dst)
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NamingStrategy builds the name of a field in a source from its words.
+type NamingStrategy interface {
+	// Name joins lowercase words with delimiter unless it's empty.
+	Name(words []string, delimiter string) string
+}
+
+// NamingFunc allows using an ordinary function as NamingStrategy.
+type NamingFunc func(words []string, delimiter string) string
+
+func (f NamingFunc) Name(words []string, delimiter string) string {
+	return f(words, delimiter)
+}
+
+var (
+	// SnakeCase names fields like database_max_conns
+	SnakeCase NamingStrategy = separated("_", strings.ToLower)
+
+	// KebabCase names fields like database-max-conns
+	KebabCase NamingStrategy = separated("-", strings.ToLower)
+
+	// ScreamingSnakeCase names fields like DATABASE_MAX_CONNS
+	ScreamingSnakeCase NamingStrategy = separated("_", strings.ToUpper)
+
+	// CamelCase names fields like databaseMaxConns
+	CamelCase NamingStrategy = NamingFunc(func(words []string, _ string) string {
+		if len(words) == 0 {
+			return ""
+		}
+
+		return words[0] + capitalize(words[1:])
+	})
+
+	// PascalCase names fields like DatabaseMaxConns
+	PascalCase NamingStrategy = NamingFunc(func(words []string, _ string) string {
+		return capitalize(words)
+	})
+)
+
+// acronyms holds words which can't be split by letter case, e.g. IPv6.
+var acronyms = sortAcronyms([]string{
+	"OAuth2", "OAuth", "IPv4", "IPv6", "HTTP2", "GraphQL",
+	"MySQL", "PostgreSQL", "MongoDB", "S3", "EC2",
+})
+
+// AddAcronyms adds words SplitWords keeps whole, e.g. WebRTC.
+func AddAcronyms(words ...string) {
+	acronyms = sortAcronyms(append(acronyms[:len(acronyms):len(acronyms)], words...))
+}
+
+// Longer acronyms go first, so OAuth2 wins over OAuth.
+func sortAcronyms(acronyms []string) []string {
+	sort.SliceStable(acronyms, func(i, j int) bool {
+		return len(acronyms[i]) > len(acronyms[j])
+	})
+
+	return acronyms
+}
+
+// SplitWords splits a field name into lowercase words, keeping acronyms whole.
+func SplitWords(name string) []string {
+	words := make([]string, 0)
+	start := 0 // beginning of the text not split yet
+
+	for i := 0; i < len(name); {
+		if i == start || isWordStart(name, i) {
+			if acronym := matchAcronym(name[i:], acronyms); acronym != "" {
+				words = append(words, CamelCaseToSlice(name[start:i])...)
+				words = append(words, strings.ToLower(acronym))
+				i += len(acronym)
+				start = i
+
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(name[i:])
+		i += size
+	}
+
+	return append(words, CamelCaseToSlice(name[start:])...)
+}
+
+func separated(separator string, letterCase func(string) string) NamingStrategy {
+	return NamingFunc(func(words []string, delimiter string) string {
+		if delimiter == "" {
+			delimiter = separator
+		}
+
+		return letterCase(strings.Join(words, delimiter))
+	})
+}
+
+func capitalize(words []string) string {
+	var b strings.Builder
+
+	for _, word := range words {
+		r, size := utf8.DecodeRuneInString(word)
+		b.WriteRune(unicode.ToUpper(r))
+		b.WriteString(word[size:])
+	}
+
+	return b.String()
+}
+
+func isWordStart(name string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(name[i:])
+	prev, _ := utf8.DecodeLastRuneInString(name[:i])
+
+	return unicode.IsUpper(r) && !unicode.IsUpper(prev)
+}
+
+func matchAcronym(s string, acronyms []string) string {
+	for _, acronym := range acronyms {
+		if !strings.HasPrefix(s, acronym) {
+			continue
+		}
+
+		if next, _ := utf8.DecodeRuneInString(s[len(acronym):]); unicode.IsLower(next) {
+			continue
+		}
+
+		return acronym
+	}
+
+	return ""
+}
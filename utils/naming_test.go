@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitWords(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "", expected: []string{}},
+		{input: "Hostname", expected: []string{"hostname"}},
+		{input: "MaxConns", expected: []string{"max", "conns"}},
+		{input: "HTTPSPort", expected: []string{"https", "port"}},
+		{input: "HTTPServer", expected: []string{"http", "server"}},
+		{input: "OAuth2Token", expected: []string{"oauth2", "token"}},
+		{input: "GoogleOAuthSecret", expected: []string{"google", "oauth", "secret"}},
+		{input: "IPv6Addr", expected: []string{"ipv6", "addr"}},
+		{input: "ListenIPv4", expected: []string{"listen", "ipv4"}},
+		{input: "Oauth", expected: []string{"oauth"}},
+		{input: "S3Bucket", expected: []string{"s3", "bucket"}},
+		{input: "MySQLDSN", expected: []string{"mysql", "dsn"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SplitWords(tc.input))
+		})
+	}
+}
+
+func TestNamingStrategy(t *testing.T) {
+	words := []string{"database", "max", "conns"}
+
+	testCases := []struct {
+		name      string
+		strategy  NamingStrategy
+		delimiter string
+		expected  string
+	}{
+		{name: "snake", strategy: SnakeCase, expected: "database_max_conns"},
+		{name: "kebab", strategy: KebabCase, expected: "database-max-conns"},
+		{name: "screaming", strategy: ScreamingSnakeCase, expected: "DATABASE_MAX_CONNS"},
+		{name: "screaming-delimiter", strategy: ScreamingSnakeCase, delimiter: "__", expected: "DATABASE__MAX__CONNS"},
+		{name: "camel", strategy: CamelCase, delimiter: "_", expected: "databaseMaxConns"},
+		{name: "pascal", strategy: PascalCase, expected: "DatabaseMaxConns"},
+		{
+			name: "custom",
+			strategy: NamingFunc(func(words []string, _ string) string {
+				return words[len(words)-1]
+			}),
+			expected: "conns",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.strategy.Name(words, tc.delimiter))
+		})
+	}
+}
+
+func TestAddAcronyms(t *testing.T) {
+	defaultAcronyms := acronyms
+	defer func() { acronyms = defaultAcronyms }()
+
+	assert.Equal(t, []string{"web", "rtc", "peer"}, SplitWords("WebRTCPeer"))
+
+	AddAcronyms("WebRTC")
+	assert.Equal(t, []string{"webrtc", "peer"}, SplitWords("WebRTCPeer"))
+	assert.Equal(t, []string{"oauth2", "token"}, SplitWords("OAuth2Token"))
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// SetFromString converts s to the kind of value, slices are comma-separated.
+func SetFromString(value reflect.Value, s string) error {
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(s)
+
+	case reflect.Bool:
+		val, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		value.SetBool(val)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetUint(val)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetInt(val)
+
+	case reflect.Float64:
+		val, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetFloat(val)
+
+	case reflect.Slice:
+		elemKind := value.Type().Elem().Kind()
+		switch elemKind {
+		case reflect.String:
+			vals := strings.Split(s, ",")
+			slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+
+			for i, v := range vals {
+				slice.Index(i).SetString(v)
+			}
+
+			value.Set(slice)
+
+		case reflect.Bool:
+			vals := strings.Split(s, ",")
+			slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+
+			for i, v := range vals {
+				val, err := strconv.ParseBool(v)
+				if err != nil {
+					return err
+				}
+
+				slice.Index(i).SetBool(val)
+			}
+
+			value.Set(slice)
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			vals := strings.Split(s, ",")
+			slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+
+			for i, v := range vals {
+				val, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				slice.Index(i).SetUint(val)
+			}
+
+			value.Set(slice)
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			vals := strings.Split(s, ",")
+			slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+
+			for i, v := range vals {
+				val, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				slice.Index(i).SetInt(val)
+			}
+
+			value.Set(slice)
+
+		case reflect.Float64:
+			vals := strings.Split(s, ",")
+			slice := reflect.MakeSlice(value.Type(), len(vals), len(vals))
+
+			for i, v := range vals {
+				val, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					return err
+				}
+
+				slice.Index(i).SetFloat(val)
+			}
+
+			value.Set(slice)
+
+		default:
+			panic(fmt.Sprintf("slice type not supported: `%s`", value.Type().Name()))
+		}
+
+	default:
+		panic(fmt.Sprintf("type not supported: `%s`", value.Type().Name()))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFromString(t *testing.T) {
+	type Config struct {
+		Name   string
+		Debug  bool
+		Port   uint16
+		Offset int32
+		Ratio  float64
+		Tags   []string
+		Levels []int64
+	}
+
+	testCases := []struct {
+		name     string
+		field    string
+		input    string
+		expected interface{}
+		err      bool
+	}{
+		{name: "string", field: "Name", input: "hello", expected: "hello"},
+		{name: "bool", field: "Debug", input: "true", expected: true},
+		{name: "uint", field: "Port", input: "8080", expected: uint16(8080)},
+		{name: "int", field: "Offset", input: "-12", expected: int32(-12)},
+		{name: "float", field: "Ratio", input: "0.75", expected: 0.75},
+		{name: "string-slice", field: "Tags", input: "one,two", expected: []string{"one", "two"}},
+		{name: "int-slice", field: "Levels", input: "3,-2,1", expected: []int64{3, -2, 1}},
+		{name: "invalid-bool", field: "Debug", input: "maybe", err: true},
+		{name: "invalid-int-slice", field: "Levels", input: "1,two", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg Config
+			value := reflect.ValueOf(&cfg).Elem().FieldByName(tc.field)
+
+			err := SetFromString(value, tc.input)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, value.Interface())
+			}
+		})
+	}
+}
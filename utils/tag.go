@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldWords returns words naming the last of fields in a source with its own tags.
+func FieldWords(fields []reflect.StructField, tagName, alias string) []string {
+	words := make([]string, 0, len(fields))
+
+	for i, field := range fields {
+		name := TagName(field.Tag, tagName)
+		if i == len(fields)-1 && alias != "" {
+			name = alias
+		}
+
+		if strings.HasPrefix(name, "/") {
+			name = strings.TrimPrefix(name, "/")
+			words = words[:0]
+		}
+
+		if name != "" {
+			words = append(words, strings.ToLower(name))
+		} else {
+			words = append(words, SplitWords(field.Name)...)
+		}
+	}
+
+	return words
+}
+
+// TagName returns the name set by the tag, e.g. db for `flag:"db,count"`.
+func TagName(tag reflect.StructTag, tagName string) string {
+	name, _, _ := strings.Cut(tag.Get(tagName), ",")
+
+	return name
+}
+
+// Sources of config values which fields can be excluded from.
+const (
+	SourceFile = "file"
+	SourceDir  = "dir"
+	SourceEnv  = "env"
+	SourceFlag = "flag"
+)
+
+// FromSource reports whether the last of fields may be read from source.
+func FromSource(fields []reflect.StructField, source string) bool {
+	for _, field := range fields {
+		if HasTagOption(field.Tag, "yacl", "no"+source) {
+			return false
+		}
+
+		if sources, ok := field.Tag.Lookup("sources"); ok && !contains(strings.Split(sources, ","), source) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasTagOption reports whether option follows the name in the tag.
+func HasTagOption(tag reflect.StructTag, tagName, option string) bool {
+	_, options, _ := strings.Cut(tag.Get(tagName), ",")
+
+	return contains(strings.Split(options, ","), option)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TagOptions returns values following the name in the tag.
+func TagOptions(tag reflect.StructTag, tagName string) []string {
+	_, options, _ := strings.Cut(tag.Get(tagName), ",")
+	values := make([]string, 0)
+
+	for _, v := range strings.Split(options, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldWords(t *testing.T) {
+	type DatabaseConfig struct {
+		MaxConns int
+		Hostname string `env:"HOST,/DB_HOST"`
+		URL      string `env:"/DATABASE_URL"`
+	}
+
+	type Config struct {
+		Database DatabaseConfig `env:"db"`
+		Replica  DatabaseConfig
+	}
+
+	field := func(path ...string) []reflect.StructField {
+		fields := make([]reflect.StructField, 0, len(path))
+		typ := reflect.TypeOf(Config{})
+
+		for _, name := range path {
+			f, _ := typ.FieldByName(name)
+			fields = append(fields, f)
+			typ = f.Type
+		}
+
+		return fields
+	}
+
+	testCases := []struct {
+		name     string
+		fields   []reflect.StructField
+		alias    string
+		expected []string
+	}{
+		{name: "no-tags", fields: field("Replica", "MaxConns"), expected: []string{"replica", "max", "conns"}},
+		{name: "parent-tag", fields: field("Database", "MaxConns"), expected: []string{"db", "max", "conns"}},
+		{name: "tag", fields: field("Replica", "Hostname"), expected: []string{"replica", "host"}},
+		{name: "alias", fields: field("Replica", "Hostname"), alias: "/DB_HOST", expected: []string{"db_host"}},
+		{name: "absolute", fields: field("Database", "URL"), expected: []string{"database_url"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FieldWords(tc.fields, "env", tc.alias))
+		})
+	}
+}
+
+func TestFromSource(t *testing.T) {
+	type Credentials struct {
+		Password string
+	}
+
+	type Config struct {
+		Hostname    string
+		Token       string      `yacl:",noflag,noenv"`
+		Key         string      `sources:"file, dir"`
+		Credentials Credentials `yacl:"creds,noflag"`
+	}
+
+	typ := reflect.TypeOf(Config{})
+	field := func(name string) []reflect.StructField {
+		f, _ := typ.FieldByName(name)
+		return []reflect.StructField{f}
+	}
+
+	credentials := field("Credentials")
+	password, _ := credentials[0].Type.FieldByName("Password")
+
+	assert.True(t, FromSource(field("Hostname"), SourceFlag))
+	assert.False(t, FromSource(field("Token"), SourceFlag))
+	assert.False(t, FromSource(field("Token"), SourceEnv))
+	assert.True(t, FromSource(field("Token"), SourceFile))
+	assert.True(t, FromSource(field("Key"), SourceDir))
+	assert.False(t, FromSource(field("Key"), SourceEnv))
+	assert.False(t, FromSource(append(credentials, password), SourceFlag))
+	assert.True(t, FromSource(append(credentials, password), SourceEnv))
+}
+
+func TestTagOptions(t *testing.T) {
+	type Config struct {
+		URL  string `env:"URL,/DB_URL, /DATABASE_URL,"`
+		Host string `env:"HOST"`
+	}
+
+	url := reflect.TypeOf(Config{}).Field(0)
+	host := reflect.TypeOf(Config{}).Field(1)
+
+	assert.Equal(t, []string{"/DB_URL", "/DATABASE_URL"}, TagOptions(url.Tag, "env"))
+	assert.Equal(t, "URL", TagName(url.Tag, "env"))
+	assert.Equal(t, []string{}, TagOptions(host.Tag, "env"))
+}
@@ -4,12 +4,42 @@ import "reflect"
 
 type WalkStructCallback func(fieldPath []string, field reflect.Value, tag *reflect.StructTag) error
 
+type WalkNestedStructCallback func(fieldPath []string, value reflect.Value) error
+
+// WalkStructFieldsCallback receives struct fields from the root to the leaf.
+type WalkStructFieldsCallback func(fields []reflect.StructField, value reflect.Value) error
+
 func WalkStruct[T any](s *T, callback WalkStructCallback) error {
 	value := reflect.ValueOf(s).Elem()
 
 	return walkStruct(value, []string{}, callback, nil)
 }
 
+// WalkNestedStruct calls callback for s and its nested structs, innermost first.
+func WalkNestedStruct[T any](s *T, callback WalkNestedStructCallback) error {
+	value := reflect.ValueOf(s).Elem()
+
+	return walkNestedStruct(value, []string{}, callback)
+}
+
+// WalkStructFields calls callback for every field of s which isn't a struct.
+func WalkStructFields[T any](s *T, callback WalkStructFieldsCallback) error {
+	value := reflect.ValueOf(s).Elem()
+
+	return walkStructFields(value, []reflect.StructField{}, callback)
+}
+
+// FieldPath returns names of fields.
+func FieldPath(fields []reflect.StructField) []string {
+	fieldPath := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		fieldPath = append(fieldPath, field.Name)
+	}
+
+	return fieldPath
+}
+
 func walkStruct(value reflect.Value, fields []string, callback WalkStructCallback, tag *reflect.StructTag) error {
 	switch value.Kind() {
 	case reflect.Struct:
@@ -19,6 +49,10 @@ func walkStruct(value reflect.Value, fields []string, callback WalkStructCallbac
 			fieldName := fieldType.Name
 			fieldTag := fieldType.Tag
 
+			if !fieldType.IsExported() {
+				continue
+			}
+
 			if field.Kind() == reflect.Struct {
 				if err := walkStruct(field, append(fields, fieldName), callback, &fieldTag); err != nil {
 					return err
@@ -38,3 +72,56 @@ func walkStruct(value reflect.Value, fields []string, callback WalkStructCallbac
 
 	return nil
 }
+
+func walkNestedStruct(value reflect.Value, fields []string, callback WalkNestedStructCallback) error {
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		fieldName := value.Type().Field(i).Name
+
+		if field.Kind() != reflect.Struct || !value.Type().Field(i).IsExported() {
+			continue
+		}
+
+		fieldPath := make([]string, 0, len(fields)+1)
+		fieldPath = append(fieldPath, fields...)
+		fieldPath = append(fieldPath, fieldName)
+
+		if err := walkNestedStruct(field, fieldPath, callback); err != nil {
+			return err
+		}
+	}
+
+	return callback(fields, value)
+}
+
+func walkStructFields(value reflect.Value, fields []reflect.StructField, callback WalkStructFieldsCallback) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+
+		if !value.Type().Field(i).IsExported() {
+			continue
+		}
+
+		fieldsCopy := make([]reflect.StructField, 0, len(fields)+1)
+		fieldsCopy = append(fieldsCopy, fields...)
+		fieldsCopy = append(fieldsCopy, value.Type().Field(i))
+
+		var err error
+
+		if field.Kind() == reflect.Struct {
+			err = walkStructFields(field, fieldsCopy, callback)
+		} else {
+			err = callback(fieldsCopy, field)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
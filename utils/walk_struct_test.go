@@ -76,3 +76,43 @@ func TestWalkStruct(t *testing.T) {
 		assert.Equal(t, expectedFieldValues[i].String(), fieldValues[i].String())
 	}
 }
+
+func TestWalkNestedStruct(t *testing.T) {
+	type Address struct {
+		Street string
+	}
+
+	type Contacts struct {
+		Home Address
+		Work Address
+	}
+
+	type Person struct {
+		Name     string
+		Contacts Contacts
+	}
+
+	person := Person{
+		Name: "John Doe",
+		Contacts: Contacts{
+			Home: Address{Street: "123 Main St"},
+			Work: Address{Street: "456 Second St"},
+		},
+	}
+
+	var fieldPaths []string
+	var typeNames []string
+
+	callback := func(fieldPath []string, value reflect.Value) error {
+		fieldPaths = append(fieldPaths, strings.Join(fieldPath, "."))
+		typeNames = append(typeNames, value.Type().Name())
+
+		return nil
+	}
+
+	err := WalkNestedStruct(&person, callback)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"Contacts.Home", "Contacts.Work", "Contacts", ""}, fieldPaths)
+	assert.Equal(t, []string{"Address", "Address", "Contacts", "Person"}, typeNames)
+}
@@ -0,0 +1,33 @@
+package yacl
+
+import (
+	"reflect"
+
+	"github.com/andrew528i/yacl/utils"
+)
+
+// Validator is implemented by config structs with rules spanning several fields.
+type Validator interface {
+	Validate() error
+}
+
+func validate[T any](cfg *T) error {
+	callback := func(fieldPath []string, value reflect.Value) error {
+		if !value.CanAddr() || !value.Addr().CanInterface() {
+			return nil // unexported struct field
+		}
+
+		validator, ok := value.Addr().Interface().(Validator)
+		if !ok {
+			return nil
+		}
+
+		if err := validator.Validate(); err != nil {
+			return NewValidationError(fieldPath, err)
+		}
+
+		return nil
+	}
+
+	return utils.WalkNestedStruct(cfg, callback)
+}
@@ -0,0 +1,92 @@
+package yacl
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validateTLSConfig struct {
+	CertFile string
+	KeyFile  string
+}
+
+func (s *validateTLSConfig) Validate() error {
+	if (s.CertFile == "") != (s.KeyFile == "") {
+		return errors.New("cert file and key file must be set together")
+	}
+
+	return nil
+}
+
+type validateConfig struct {
+	MinConns uint
+	MaxConns uint
+	TLS      validateTLSConfig
+}
+
+func (s *validateConfig) Validate() error {
+	if s.MinConns > s.MaxConns {
+		return errors.New("min conns must not exceed max conns")
+	}
+
+	return nil
+}
+
+func TestYACL_ParseValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		vars      map[string]string
+		fieldPath []string
+	}{
+		{
+			name: "valid",
+			vars: map[string]string{
+				"MIN_CONNS":     "1",
+				"MAX_CONNS":     "10",
+				"TLS_CERT_FILE": "cert.pem",
+				"TLS_KEY_FILE":  "key.pem",
+			},
+		}, {
+			name: "invalid-root",
+			vars: map[string]string{
+				"MIN_CONNS": "10",
+				"MAX_CONNS": "1",
+			},
+			fieldPath: []string{},
+		}, {
+			name: "invalid-nested",
+			vars: map[string]string{
+				"MAX_CONNS":     "10",
+				"TLS_CERT_FILE": "cert.pem",
+			},
+			fieldPath: []string{"TLS"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.vars {
+				assert.NoError(t, os.Setenv(k, v))
+			}
+
+			y := New[validateConfig]()
+			y.SetIgnoreFlags(true)
+			cfg, err := y.Parse()
+
+			if tc.fieldPath == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, cfg)
+			} else {
+				var validationErr *ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tc.fieldPath, validationErr.FieldPath)
+				assert.Nil(t, cfg)
+			}
+
+			os.Clearenv()
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package yacl
+
+import (
+	"context"
+	"time"
+
+	"github.com/andrew528i/yacl/file"
+)
+
+var DefaultWatchInterval = time.Second
+
+type subscriber[T any] struct {
+	id       int
+	callback func(old, new *T)
+}
+
+func (s *YACL[T]) SetWatchInterval(interval time.Duration) {
+	s.watchInterval = interval
+}
+
+// SetReloadErrorHandler sets a function receiving errors of config reloads.
+func (s *YACL[T]) SetReloadErrorHandler(f func(error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.onReloadError = f
+}
+
+// Watch calls callback with the old and the new config every time files change.
+func (s *YACL[T]) Watch(ctx context.Context, callback func(old, new *T)) error {
+	if s.store.Load() == nil {
+		if _, err := s.Parse(); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextSubscriberID
+	s.nextSubscriberID++
+	s.subscribers = append(s.subscribers, subscriber[T]{id: id, callback: callback})
+
+	go func() {
+		<-ctx.Done()
+		s.unsubscribe(id)
+	}()
+
+	if !s.watching {
+		s.watching = true
+		go s.watch(s.watcher)
+	}
+
+	return nil
+}
+
+func (s *YACL[T]) watch(watcher *file.Watcher) {
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		if len(s.subscribers) == 0 {
+			s.watching = false
+			s.mu.Unlock()
+
+			return
+		}
+		s.mu.Unlock()
+
+		if !watcher.Changed() {
+			continue
+		}
+
+		if err := s.reload(); err != nil {
+			s.handleReloadError(err, nil)
+		}
+	}
+}
+
+func (s *YACL[T]) reload() error {
+	s.mu.Lock()
+	src := s.sources
+	s.mu.Unlock()
+
+	cfg, err := s.parse(src)
+	if err != nil {
+		return err
+	}
+
+	s.publish(cfg)
+
+	return nil
+}
+
+func (s *YACL[T]) handleReloadError(err error, onError func(error)) {
+	if onError == nil {
+		s.mu.Lock()
+		onError = s.onReloadError
+		s.mu.Unlock()
+	}
+
+	if onError != nil {
+		onError(err)
+	}
+}
+
+func (s *YACL[T]) publish(cfg *T) {
+	s.mu.Lock()
+	old := s.store.Load()
+	s.store.Publish(cfg)
+	subscribers := make([]subscriber[T], len(s.subscribers))
+	copy(subscribers, s.subscribers)
+	s.mu.Unlock()
+
+	for _, sub := range subscribers {
+		sub.callback(old, cfg)
+	}
+}
+
+func (s *YACL[T]) unsubscribe(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, sub := range s.subscribers {
+		if sub.id == id {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package yacl
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type watchConfig struct {
+	Endpoint string
+}
+
+func (s *watchConfig) Validate() error {
+	if s.Endpoint == "invalid" {
+		return errors.New("invalid endpoint")
+	}
+
+	return nil
+}
+
+func TestYACL_Watch(t *testing.T) {
+	tempDir := t.TempDir()
+	tempFile := filepath.Join(tempDir, "config.yaml")
+
+	writeConfig := func(endpoint string, modTime time.Time) {
+		assert.NoError(t, os.WriteFile(tempFile, []byte("endpoint: "+endpoint), 0644))
+		assert.NoError(t, os.Chtimes(tempFile, modTime, modTime))
+	}
+
+	writeConfig("first", time.Now())
+
+	y := New[watchConfig]()
+	y.SetIgnoreFlags(true)
+	y.AddFilePath(tempDir)
+	y.SetWatchInterval(10 * time.Millisecond)
+
+	reloadErrors := make(chan error, 1)
+	y.SetReloadErrorHandler(func(err error) {
+		reloadErrors <- err
+	})
+
+	type change struct {
+		old, new *watchConfig
+	}
+
+	changes := make(chan change, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := y.Watch(ctx, func(old, new *watchConfig) {
+		changes <- change{old, new}
+	})
+	assert.NoError(t, err)
+
+	// Valid config is published
+	writeConfig("second", time.Now().Add(time.Second))
+
+	select {
+	case c := <-changes:
+		assert.Equal(t, "first", c.old.Endpoint)
+		assert.Equal(t, "second", c.new.Endpoint)
+	case <-time.After(time.Second):
+		t.Fatal("config change was not delivered")
+	}
+
+	// Invalid config is not published
+	writeConfig("invalid", time.Now().Add(2*time.Second))
+
+	select {
+	case err = <-reloadErrors:
+		var validationErr *ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	case <-time.After(time.Second):
+		t.Fatal("reload error was not reported")
+	}
+
+	select {
+	case c := <-changes:
+		t.Fatalf("invalid config was published: %+v", c.new)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestYACL_WatchSinceParse(t *testing.T) {
+	tempDir := t.TempDir()
+	secretsDir := t.TempDir()
+	tempFile := filepath.Join(tempDir, "config.yaml")
+	secretFile := filepath.Join(secretsDir, "endpoint")
+
+	assert.NoError(t, os.WriteFile(tempFile, []byte("endpoint: first"), 0644))
+
+	y := New[watchConfig]()
+	y.SetIgnoreFlags(true)
+	y.AddFilePath(tempDir)
+	y.AddDirPath(secretsDir)
+	y.SetWatchInterval(10 * time.Millisecond)
+
+	_, err := y.Parse()
+	assert.NoError(t, err)
+
+	// The file in the directory is created before Watch is called
+	assert.NoError(t, os.WriteFile(secretFile, []byte("second"), 0644))
+
+	changes := make(chan *watchConfig, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err = y.Watch(ctx, func(_, new *watchConfig) {
+		changes <- new
+	})
+	assert.NoError(t, err)
+
+	select {
+	case cfg := <-changes:
+		assert.Equal(t, "second", cfg.Endpoint)
+	case <-time.After(time.Second):
+		t.Fatal("config change was not delivered")
+	}
+}
@@ -1,6 +1,11 @@
 package yacl
 
 import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/andrew528i/yacl/dir"
 	"github.com/andrew528i/yacl/env"
 	"github.com/andrew528i/yacl/file"
 	"github.com/andrew528i/yacl/flags"
@@ -11,8 +16,25 @@ type YACL[T any] struct {
 	flags *flags.Params
 	env   *env.Params
 	file  *file.Params
+	dir   *dir.Params
 
 	ignoreFlags bool
+
+	sources       sources[T]
+	watchInterval time.Duration
+	onReloadError func(error)
+
+	// parseMu serializes parsing since flags are bound to the global flag set
+	parseMu sync.Mutex
+
+	store *Store[T]
+
+	mu               sync.Mutex
+	command          string
+	watcher          *file.Watcher
+	subscribers      []subscriber[T]
+	nextSubscriberID int
+	watching         bool
 }
 
 func New[T any]() *YACL[T] {
@@ -20,6 +42,10 @@ func New[T any]() *YACL[T] {
 		flags: flags.DefaultParams(),
 		env:   env.DefaultParams(),
 		file:  file.DefaultParams(),
+		dir:   dir.DefaultParams(),
+
+		store:         NewStore[T](nil),
+		watchInterval: DefaultWatchInterval,
 	}
 }
 
@@ -31,18 +57,56 @@ func (s *YACL[T]) SetEnvDelimiter(delimiter string) {
 	s.env.Delimiter = delimiter
 }
 
+func (s *YACL[T]) SetEnvNaming(naming utils.NamingStrategy) {
+	s.env.Naming = naming
+}
+
+func (s *YACL[T]) SetEnvUnknownPolicy(policy env.UnknownPolicy) {
+	s.env.Unknown = policy
+}
+
 func (s *YACL[T]) AddFilePath(path string) {
 	s.file.Paths = append(s.file.Paths, path)
 }
 
+func (s *YACL[T]) AddDirPath(path string) {
+	s.dir.Paths = append(s.dir.Paths, path)
+}
+
 func (s *YACL[T]) SetFilename(filename string) {
 	s.file.Filename = filename
 }
 
+func (s *YACL[T]) SetFileInterpolation(v bool) {
+	s.file.Interpolate = v
+}
+
+func (s *YACL[T]) SetFileDotenv(v bool) {
+	s.file.Dotenv = v
+}
+
+func (s *YACL[T]) SetFileStrict(v bool) {
+	s.file.Strict = v
+}
+
+func (s *YACL[T]) SetFileNaming(naming utils.NamingStrategy) {
+	s.file.Naming = naming
+	s.dir.Naming = naming
+}
+
 func (s *YACL[T]) SetFlagDelimiter(delimiter string) {
 	s.flags.Delimiter = delimiter
 }
 
+func (s *YACL[T]) SetFlagNaming(naming utils.NamingStrategy) {
+	s.flags.Naming = naming
+}
+
+func (s *YACL[T]) SetFlagCompletion(v bool) {
+	s.flags.Completion = v
+}
+
+// Deprecated: use SetFlagNaming instead.
 func (s *YACL[T]) SetFlagFormatFunc(f func([]string)) {
 	s.flags.FieldPathFormatFunc = f
 }
@@ -51,16 +115,103 @@ func (s *YACL[T]) SetIgnoreFlags(v bool) {
 	s.ignoreFlags = v
 }
 
+// sources holds everything besides files a config was parsed from.
+type sources[T any] struct {
+	defaultConfigs []*T
+	args           []string
+	environ        []string
+}
+
 func (s *YACL[T]) Parse(defaultConfigs ...*T) (*T, error) {
+	src := sources[T]{
+		defaultConfigs: defaultConfigs,
+		args:           s.flags.Args,
+		environ:        s.env.Environ,
+	}
+
+	if src.args == nil {
+		src.args = os.Args[1:]
+	}
+
+	if src.environ == nil {
+		src.environ = os.Environ()
+	}
+
+	// Files are stated before reading, so Watch notices changes made since
+	watcher := file.NewWatcher(s.file, s.dir.Paths...)
+
+	cfg, err := s.parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.sources = src
+	s.watcher = watcher
+	s.mu.Unlock()
+
+	s.publish(cfg)
+
+	return cfg, nil
+}
+
+// Command returns the name of the subcommand selected by the last Parse.
+func (s *YACL[T]) Command() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.command
+}
+
+// Completion returns the completion script of the shell for the program.
+func (s *YACL[T]) Completion(shell, program string) (string, error) {
+	return flags.Completion[T](s.flags, shell, program)
+}
+
+// Load parses the config like Parse and returns the store holding it.
+func (s *YACL[T]) Load(defaultConfigs ...*T) (*Store[T], error) {
+	if _, err := s.Parse(defaultConfigs...); err != nil {
+		return nil, err
+	}
+
+	return s.store, nil
+}
+
+func (s *YACL[T]) parse(src sources[T]) (*T, error) {
+	s.parseMu.Lock()
+	defer s.parseMu.Unlock()
+
 	var cfg T
+	var command string
+
+	envParams := *s.env
+	envParams.Environ = src.environ
+
+	flagParams := *s.flags
+	flagParams.Args = src.args
+
+	// Variables from .env file are overridden by the environment
+	if s.file.Dotenv {
+		dotenvVars, err := file.ParseDotenv(s.file)
+		if err != nil {
+			if _, ok := err.(*file.NotFound); !ok {
+				return nil, err
+			}
+		} else {
+			envParams.Environ = append(dotenvVars, src.environ...)
+		}
+	}
+
+	fileParams := *s.file
+	fileParams.Environ = envParams.Environ
 
 	// First use default configs if they provided
-	for _, defaultConfig := range defaultConfigs {
+	for _, defaultConfig := range src.defaultConfigs {
 		utils.MergeStruct(&cfg, defaultConfig)
 	}
 
 	// Merge config values from yaml file
-	yamlCfg, err := file.ParseYAML[T](s.file)
+	yamlCfg, err := file.ParseYAML[T](&fileParams)
 	if err != nil {
 		if _, ok := err.(*file.NotFound); !ok {
 			return nil, err
@@ -70,7 +221,7 @@ func (s *YACL[T]) Parse(defaultConfigs ...*T) (*T, error) {
 	}
 
 	// Merge config values from json file
-	jsonCfg, err := file.ParseJSON[T](s.file)
+	jsonCfg, err := file.ParseJSON[T](&fileParams)
 	if err != nil {
 		if _, ok := err.(*file.NotFound); !ok {
 			return nil, err
@@ -80,7 +231,7 @@ func (s *YACL[T]) Parse(defaultConfigs ...*T) (*T, error) {
 	}
 
 	// Merge config values from json file
-	binaryCfg, err := file.ParseBinary[T](s.file)
+	binaryCfg, err := file.ParseBinary[T](&fileParams)
 	if err != nil {
 		if _, ok := err.(*file.NotFound); !ok {
 			return nil, err
@@ -89,8 +240,16 @@ func (s *YACL[T]) Parse(defaultConfigs ...*T) (*T, error) {
 		utils.MergeStruct(&cfg, binaryCfg)
 	}
 
+	// Merge config values from directories with a file per field
+	dirCfg, err := dir.Parse[T](s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.MergeStruct(&cfg, dirCfg)
+
 	// Merge config values from env
-	envCfg, err := env.Parse[T](s.env)
+	envCfg, err := env.Parse[T](&envParams)
 	if err != nil {
 		return nil, err
 	}
@@ -99,13 +258,31 @@ func (s *YACL[T]) Parse(defaultConfigs ...*T) (*T, error) {
 
 	// Merge config values from command line interface
 	if !s.ignoreFlags {
-		flagCfg, err := flags.Parse[T](s.flags)
+		flagResult, err := flags.ParseResult[T](&flagParams)
 		if err != nil {
 			return nil, err
 		}
 
-		utils.MergeStruct(&cfg, flagCfg)
+		// Bool flags set explicitly override other sources even with false
+		utils.MergeStruct(&cfg, flagResult.Config)
+		utils.CopyFields(&cfg, flagResult.Config, flagResult.Explicit)
+
+		command = flagResult.Command
+	}
+
+	// Resolve references between fields once all the overrides are applied
+	if err = resolveReferences(&cfg, s.file); err != nil {
+		return nil, err
 	}
 
+	// Check the merged config with user defined rules
+	if err = validate(&cfg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.command = command
+	s.mu.Unlock()
+
 	return &cfg, nil
 }
@@ -2,6 +2,7 @@ package yacl
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,3 +29,117 @@ func TestYACL_SetEnvPrefix(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "localhost-123", secondCfg.Hostname)
 }
+
+func TestYACL_ParseDotenv(t *testing.T) {
+	type Config struct {
+		Endpoint string
+		Port     uint
+	}
+
+	tempDir := t.TempDir()
+	dotenv := "export ENDPOINT=localhost-dotenv\nPORT=8080\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".env"), []byte(dotenv), 0644))
+	assert.NoError(t, os.Setenv("PORT", "9090"))
+
+	// .env file is read only when it's enabled
+	y := New[Config]()
+	y.SetIgnoreFlags(true)
+	y.AddFilePath(tempDir)
+	cfg, err := y.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Port: 9090}, *cfg)
+
+	y.SetFileDotenv(true)
+	cfg, err = y.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Endpoint: "localhost-dotenv", Port: 9090}, *cfg)
+
+	// Process environment isn't touched
+	_, ok := os.LookupEnv("ENDPOINT")
+	assert.False(t, ok)
+
+	os.Clearenv()
+}
+
+func TestYACL_ParseInterpolate(t *testing.T) {
+	type Config struct {
+		Endpoint string
+		Port     uint
+	}
+
+	tempDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, ".env"), []byte("API_HOST=api.local\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte("endpoint: https://${API_HOST}\nport: ${API_PORT:-443}\n"), 0644))
+
+	y := New[Config]()
+	y.SetIgnoreFlags(true)
+	y.SetFileDotenv(true)
+	y.SetFileInterpolation(true)
+	y.AddFilePath(tempDir)
+	cfg, err := y.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Endpoint: "https://api.local", Port: 443}, *cfg)
+}
+
+func TestYACL_ParseSources(t *testing.T) {
+	type DatabaseConfig struct {
+		Hostname string
+		Password string `yacl:",noflag"`
+	}
+
+	type Config struct {
+		Database DatabaseConfig
+		Token    string `sources:"file"`
+		Debug    bool   `yacl:",noenv,nofile"`
+
+		cache map[string]string
+	}
+
+	tempDir := t.TempDir()
+	yamlConfig := "database:\n  hostname: localhost-yaml\ntoken: token-yaml\ndebug: true\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(tempDir, "config.yaml"), []byte(yamlConfig), 0644))
+
+	y := New[Config]()
+	y.AddFilePath(tempDir)
+	y.env.Environ = []string{"DATABASE_PASSWORD=secret-env", "TOKEN=token-env", "DEBUG=true"}
+	y.flags.Args = []string{"-database-hostname", "localhost-flags"}
+
+	cfg, err := y.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, DatabaseConfig{Hostname: "localhost-flags", Password: "secret-env"}, cfg.Database)
+	assert.Equal(t, "token-yaml", cfg.Token)
+	assert.False(t, cfg.Debug)
+}
+
+func TestYACL_ParseNegatedFlag(t *testing.T) {
+	type Config struct {
+		CanRestart bool
+	}
+
+	y := New[Config]()
+	y.env.Environ = []string{"CAN_RESTART=true"}
+	y.flags.Args = []string{"--no-can-restart"}
+
+	cfg, err := y.Parse(&Config{CanRestart: true})
+	assert.NoError(t, err)
+	assert.False(t, cfg.CanRestart)
+}
+
+func TestYACL_Command(t *testing.T) {
+	type ServeConfig struct {
+		Port uint
+	}
+
+	type Config struct {
+		Serve ServeConfig `cmd:"serve"`
+	}
+
+	y := New[Config]()
+	y.env.Environ = []string{"SERVE_PORT=8080"}
+	y.flags.Args = []string{"serve"}
+
+	cfg, err := y.Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, "serve", y.Command())
+	assert.Equal(t, uint(8080), cfg.Serve.Port)
+}